@@ -0,0 +1,143 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Upload represents a file uploaded to /uploads.json and attached to an
+// issue on create or update through its token
+type Upload struct {
+	Token       string `json:"token"`
+	Filename    string `json:"filename,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// UploadResponse represents the response from the uploads endpoint
+type UploadResponse struct {
+	Upload struct {
+		ID    int    `json:"id"`
+		Token string `json:"token"`
+	} `json:"upload"`
+}
+
+// AttachmentResponse represents the response for a single attachment
+type AttachmentResponse struct {
+	Attachment Attachment `json:"attachment"`
+}
+
+// transferClient returns an HTTP client without an overall timeout so large
+// uploads and downloads are not cut off mid-stream
+func (c *Client) transferClient() *http.Client {
+	return &http.Client{Transport: c.HTTPClient.Transport}
+}
+
+// UploadFile streams content to /uploads.json and returns the upload token.
+// size is sent as Content-Length when known (>= 0).
+func (c *Client) UploadFile(filename string, content io.Reader, size int64) (*UploadResponse, error) {
+	endpoint := c.BaseURL + "/uploads.json?filename=" + url.QueryEscape(filename)
+
+	req, err := http.NewRequest("POST", endpoint, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Redmine-API-Key", c.APIKey)
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if size >= 0 {
+		req.ContentLength = size
+	}
+
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload %s: %w", filename, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var uploadResp UploadResponse
+	if err := json.Unmarshal(body, &uploadResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &uploadResp, nil
+}
+
+// GetAttachment retrieves the metadata of an attachment
+func (c *Client) GetAttachment(id int) (*AttachmentResponse, error) {
+	resp, err := c.makeRequest("GET", fmt.Sprintf("/attachments/%d.json", id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var attachmentResp AttachmentResponse
+	if err := json.Unmarshal(body, &attachmentResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &attachmentResp, nil
+}
+
+// DownloadAttachment streams the content of an attachment into w and
+// returns the number of bytes written
+func (c *Client) DownloadAttachment(attachment Attachment, w io.Writer) (int64, error) {
+	contentURL := attachment.ContentURL
+	if contentURL == "" {
+		contentURL = fmt.Sprintf("%s/attachments/download/%d/%s", c.BaseURL, attachment.ID, url.PathEscape(attachment.Filename))
+	} else if strings.HasPrefix(contentURL, "/") {
+		contentURL = c.BaseURL + contentURL
+	}
+
+	req, err := http.NewRequest("GET", contentURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Redmine-API-Key", c.APIKey)
+
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return 0, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	written, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return written, fmt.Errorf("failed to download %s: %w", attachment.Filename, err)
+	}
+
+	return written, nil
+}
+
+// DeleteAttachment deletes an attachment
+func (c *Client) DeleteAttachment(id int) error {
+	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/attachments/%d.json", id))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -36,16 +37,74 @@ type Issue struct {
 	CreatedOn      time.Time     `json:"created_on"`
 	UpdatedOn      time.Time     `json:"updated_on"`
 	ClosedOn       *time.Time    `json:"closed_on,omitempty"`
+	Parent         *IssueRef     `json:"parent,omitempty"`
+	FixedVersion   *Version      `json:"fixed_version,omitempty"`
+	Tags           []IssueTag    `json:"tags,omitempty"`
 	CustomFields   []CustomField `json:"custom_fields,omitempty"`
 	Journals       []Journal     `json:"journals,omitempty"`
+	Attachments    []Attachment  `json:"attachments,omitempty"`
+	Relations      []Relation    `json:"relations,omitempty"`
+	Children       []IssueChild  `json:"children,omitempty"`
+	Watchers       []User        `json:"watchers,omitempty"`
+	// AllowedStatuses is returned by include=allowed_statuses (Redmine 5.0+)
+	AllowedStatuses []Status `json:"allowed_statuses,omitempty"`
+}
+
+// IssueChild is a subtask returned by include=children. Nested subtasks are
+// returned in Children.
+type IssueChild struct {
+	ID       int          `json:"id"`
+	Tracker  Tracker      `json:"tracker"`
+	Subject  string       `json:"subject"`
+	Children []IssueChild `json:"children,omitempty"`
+}
+
+// Attachment represents a file attached to an issue
+type Attachment struct {
+	ID          int       `json:"id"`
+	Filename    string    `json:"filename"`
+	Filesize    int64     `json:"filesize"`
+	ContentType string    `json:"content_type,omitempty"`
+	Description string    `json:"description,omitempty"`
+	ContentURL  string    `json:"content_url"`
+	Author      User      `json:"author"`
+	CreatedOn   time.Time `json:"created_on"`
+}
+
+// Relation represents a relation between two issues
+type Relation struct {
+	ID           int    `json:"id"`
+	IssueID      int    `json:"issue_id"`
+	IssueToID    int    `json:"issue_to_id"`
+	RelationType string `json:"relation_type"`
+	Delay        *int   `json:"delay,omitempty"`
+}
+
+// IssueTag is a tag added by a tagging plugin. Plugins return tags either
+// as plain strings or as objects with a name.
+type IssueTag struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+func (t *IssueTag) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		t.Name = name
+		return nil
+	}
+
+	type tag IssueTag
+	return json.Unmarshal(data, (*tag)(t))
 }
 
 type Journal struct {
-	ID        int             `json:"id"`
-	User      User            `json:"user"`
-	Notes     string          `json:"notes"`
-	CreatedOn time.Time       `json:"created_on"`
-	Details   []JournalDetail `json:"details,omitempty"`
+	ID           int             `json:"id"`
+	User         User            `json:"user"`
+	Notes        string          `json:"notes"`
+	PrivateNotes bool            `json:"private_notes,omitempty"`
+	CreatedOn    time.Time       `json:"created_on"`
+	Details      []JournalDetail `json:"details,omitempty"`
 }
 
 type JournalDetail struct {
@@ -56,23 +115,42 @@ type JournalDetail struct {
 }
 
 type Project struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID                  int                     `json:"id"`
+	Name                string                  `json:"name"`
+	Identifier          string                  `json:"identifier,omitempty"`
+	Description         string                  `json:"description,omitempty"`
+	Homepage            string                  `json:"homepage,omitempty"`
+	Parent              *Project                `json:"parent,omitempty"`
+	Status              int                     `json:"status,omitempty"`
+	IsPublic            *bool                   `json:"is_public,omitempty"`
+	InheritMembers      *bool                   `json:"inherit_members,omitempty"`
+	CreatedOn           *time.Time              `json:"created_on,omitempty"`
+	UpdatedOn           *time.Time              `json:"updated_on,omitempty"`
+	Trackers            []Tracker               `json:"trackers,omitempty"`
+	IssueCategories     []IssueCategory         `json:"issue_categories,omitempty"`
+	EnabledModules      []EnabledModule         `json:"enabled_modules,omitempty"`
+	TimeEntryActivities []TimeEntryActivity     `json:"time_entry_activities,omitempty"`
+	IssueCustomFields   []CustomFieldDefinition `json:"issue_custom_fields,omitempty"`
 }
 
 type Tracker struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	DefaultStatus *Status `json:"default_status,omitempty"`
+	Description   string  `json:"description,omitempty"`
 }
 
 type Status struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	IsClosed bool   `json:"is_closed,omitempty"`
 }
 
 type Priority struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default,omitempty"`
+	Active    *bool  `json:"active,omitempty"`
 }
 
 type User struct {
@@ -87,9 +165,60 @@ type User struct {
 }
 
 type CustomField struct {
-	ID    int    `json:"id"`
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	ID       int        `json:"id"`
+	Name     string     `json:"name"`
+	Multiple bool       `json:"multiple,omitempty"`
+	Value    FieldValue `json:"value"`
+}
+
+// FieldValue is the value of a custom field. Multi-value fields are
+// returned as an array and other fields as a string (or null); Array
+// records which, so the value is written back in the same form.
+type FieldValue struct {
+	Values []string
+	Array  bool
+}
+
+func (v *FieldValue) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*v = FieldValue{}
+		return nil
+	}
+	if len(data) > 0 && data[0] == '[' {
+		var values []string
+		if err := json.Unmarshal(data, &values); err != nil {
+			return err
+		}
+		*v = FieldValue{Values: values, Array: true}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*v = FieldValue{Values: []string{value}}
+	return nil
+}
+
+// MarshalJSON writes the value as it was received: an array for multi-value
+// fields, a string or null otherwise
+func (v FieldValue) MarshalJSON() ([]byte, error) {
+	if v.Array {
+		if v.Values == nil {
+			return []byte("[]"), nil
+		}
+		return json.Marshal(v.Values)
+	}
+	if len(v.Values) == 0 {
+		return []byte("null"), nil
+	}
+	return json.Marshal(strings.Join(v.Values, ", "))
+}
+
+// String joins the values of a multi-value field with commas
+func (v FieldValue) String() string {
+	return strings.Join(v.Values, ", ")
 }
 
 type IssuesResponse struct {
@@ -108,16 +237,25 @@ type CreateIssueRequest struct {
 }
 
 type CreateIssueData struct {
-	ProjectID     int    `json:"project_id"`
-	TrackerID     int    `json:"tracker_id,omitempty"`
-	StatusID      int    `json:"status_id,omitempty"`
-	PriorityID    int    `json:"priority_id,omitempty"`
-	Subject       string `json:"subject"`
-	Description   string `json:"description,omitempty"`
-	AssignedToID  int    `json:"assigned_to_id,omitempty"`
-	ParentIssueID int    `json:"parent_issue_id,omitempty"`
-	StartDate     string `json:"start_date,omitempty"`
-	DueDate       string `json:"due_date,omitempty"`
+	ProjectID      int                `json:"project_id"`
+	TrackerID      int                `json:"tracker_id,omitempty"`
+	StatusID       int                `json:"status_id,omitempty"`
+	PriorityID     int                `json:"priority_id,omitempty"`
+	Subject        string             `json:"subject"`
+	Description    string             `json:"description,omitempty"`
+	AssignedToID   int                `json:"assigned_to_id,omitempty"`
+	ParentIssueID  int                `json:"parent_issue_id,omitempty"`
+	StartDate      string             `json:"start_date,omitempty"`
+	DueDate        string             `json:"due_date,omitempty"`
+	CustomFields   []CustomFieldValue `json:"custom_fields,omitempty"`
+	Uploads        []Upload           `json:"uploads,omitempty"`
+	WatcherUserIDs []int              `json:"watcher_user_ids,omitempty"`
+}
+
+// CustomFieldValue represents a custom field value sent when creating or updating an issue
+type CustomFieldValue struct {
+	ID    int         `json:"id"`
+	Value interface{} `json:"value"`
 }
 
 // UpdateIssueRequest represents the request body for updating an issue
@@ -127,17 +265,20 @@ type UpdateIssueRequest struct {
 
 // UpdateIssueData represents the data structure for updating an issue
 type UpdateIssueData struct {
-	Subject       *string `json:"subject,omitempty"`
-	Description   *string `json:"description,omitempty"`
-	StatusID      *int    `json:"status_id,omitempty"`
-	AssignedToID  *int    `json:"assigned_to_id,omitempty"`
-	Notes         *string `json:"notes,omitempty"`
-	TrackerID     *int    `json:"tracker_id,omitempty"`
-	PriorityID    *int    `json:"priority_id,omitempty"`
-	StartDate     *string `json:"start_date,omitempty"`
-	DueDate       *string `json:"due_date,omitempty"`
-	DoneRatio     *int    `json:"done_ratio,omitempty"`
-	ParentIssueID *int    `json:"parent_issue_id,omitempty"`
+	Subject       *string            `json:"subject,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	StatusID      *int               `json:"status_id,omitempty"`
+	AssignedToID  *int               `json:"assigned_to_id,omitempty"`
+	Notes         *string            `json:"notes,omitempty"`
+	PrivateNotes  bool               `json:"private_notes,omitempty"`
+	TrackerID     *int               `json:"tracker_id,omitempty"`
+	PriorityID    *int               `json:"priority_id,omitempty"`
+	StartDate     *string            `json:"start_date,omitempty"`
+	DueDate       *string            `json:"due_date,omitempty"`
+	DoneRatio     *int               `json:"done_ratio,omitempty"`
+	ParentIssueID *int               `json:"parent_issue_id,omitempty"`
+	CustomFields  []CustomFieldValue `json:"custom_fields,omitempty"`
+	Uploads       []Upload           `json:"uploads,omitempty"`
 }
 type UserResponse struct {
 	User User `json:"user"`
@@ -153,6 +294,36 @@ func NewClient(baseURL, apiKey string) *Client {
 	}
 }
 
+// APIError is returned when Redmine responds with a non-2xx status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// IsForbidden reports whether err is a 403 response, e.g. when a
+// non-admin user calls an admin-only endpoint
+func IsForbidden(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusForbidden
+}
+
+// IsNotFound reports whether err is a 404 response
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether err is a 409 response, e.g. when a wiki page
+// was changed since the version sent with an update
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
+
 func (c *Client) makeRequest(method, endpoint string, body ...[]byte) (*http.Response, error) {
 	url := c.BaseURL + endpoint
 
@@ -177,25 +348,32 @@ func (c *Client) makeRequest(method, endpoint string, body ...[]byte) (*http.Res
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		respBody, _ := io.ReadAll(resp.Body)
 		resp.Body.Close()
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return resp, nil
 }
 
-func (c *Client) GetIssues(params map[string]string) (*IssuesResponse, error) {
-	endpoint := "/issues.json"
+// withParams appends URL-encoded query parameters to endpoint
+func withParams(endpoint string, params map[string]string) string {
+	if len(params) == 0 {
+		return endpoint
+	}
 
-	// Add query parameters
-	if len(params) > 0 {
-		paramStrings := make([]string, 0, len(params))
-		for key, value := range params {
-			paramStrings = append(paramStrings, fmt.Sprintf("%s=%s", key, value))
-		}
-		endpoint += "?" + strings.Join(paramStrings, "&")
+	values := url.Values{}
+	for key, value := range params {
+		values.Set(key, value)
 	}
 
-	resp, err := c.makeRequest("GET", endpoint)
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + values.Encode()
+}
+
+func (c *Client) GetIssues(params map[string]string) (*IssuesResponse, error) {
+	resp, err := c.makeRequest("GET", withParams("/issues.json", params))
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +392,33 @@ func (c *Client) GetIssues(params map[string]string) (*IssuesResponse, error) {
 	return &issuesResp, nil
 }
 
+// GetAllIssues pages through every issue matching params
+func (c *Client) GetAllIssues(params map[string]string) ([]Issue, error) {
+	pageParams := make(map[string]string, len(params)+2)
+	for key, value := range params {
+		pageParams[key] = value
+	}
+	pageParams["limit"] = "100"
+
+	var issues []Issue
+	for offset := 0; ; {
+		pageParams["offset"] = fmt.Sprintf("%d", offset)
+
+		issuesResp, err := c.GetIssues(pageParams)
+		if err != nil {
+			return nil, err
+		}
+
+		issues = append(issues, issuesResp.Issues...)
+		offset += len(issuesResp.Issues)
+		if len(issuesResp.Issues) == 0 || offset >= issuesResp.TotalCount {
+			break
+		}
+	}
+
+	return issues, nil
+}
+
 func (c *Client) GetIssue(id int, include ...string) (*IssueResponse, error) {
 	endpoint := fmt.Sprintf("/issues/%d.json", id)
 
@@ -305,18 +510,48 @@ func (c *Client) UpdateIssue(issueID int, req UpdateIssueRequest) (*IssueRespons
 	return &issueResp, nil
 }
 
+// SetIssueParent moves an issue under another issue, or makes it a
+// top-level issue when parentID is nil
+func (c *Client) SetIssueParent(issueID int, parentID *int) error {
+	// parent_issue_id is always sent so that null clears the parent
+	req := map[string]map[string]*int{"issue": {"parent_issue_id": parentID}}
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", fmt.Sprintf("/issues/%d.json", issueID), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
 type ProjectsResponse struct {
-	Projects []Project `json:"projects"`
+	Projects   []Project `json:"projects"`
+	TotalCount int       `json:"total_count"`
+	Offset     int       `json:"offset"`
+	Limit      int       `json:"limit"`
 }
 
 type UsersResponse struct {
-	Users []User `json:"users"`
+	Users      []User `json:"users"`
+	TotalCount int    `json:"total_count"`
+	Offset     int    `json:"offset"`
+	Limit      int    `json:"limit"`
 }
 
 type TrackersResponse struct {
 	Trackers []Tracker `json:"trackers"`
 }
 
+// IssuePrioritiesResponse represents the response from the issue priorities enumeration
+type IssuePrioritiesResponse struct {
+	IssuePriorities []Priority `json:"issue_priorities"`
+}
+
 // SearchResult represents a single search result
 type SearchResult struct {
 	ID          int    `json:"id"`
@@ -335,8 +570,8 @@ type SearchResponse struct {
 	Limit      int            `json:"limit"`
 }
 
-func (c *Client) GetProjects() (*ProjectsResponse, error) {
-	resp, err := c.makeRequest("GET", "/projects.json")
+func (c *Client) GetUsers() (*UsersResponse, error) {
+	resp, err := c.makeRequest("GET", "/users.json?limit=100")
 	if err != nil {
 		return nil, err
 	}
@@ -347,32 +582,50 @@ func (c *Client) GetProjects() (*ProjectsResponse, error) {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	var projectsResp ProjectsResponse
-	if err := json.Unmarshal(body, &projectsResp); err != nil {
+	var usersResp UsersResponse
+	if err := json.Unmarshal(body, &usersResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
-	return &projectsResp, nil
+	return &usersResp, nil
 }
 
-func (c *Client) GetUsers() (*UsersResponse, error) {
-	resp, err := c.makeRequest("GET", "/users.json?limit=100")
-	if err != nil {
-		return nil, err
+// GetAllUsers pages through every user matching params (requires admin rights)
+func (c *Client) GetAllUsers(params map[string]string) ([]User, error) {
+	pageParams := make(map[string]string, len(params)+2)
+	for key, value := range params {
+		pageParams[key] = value
 	}
-	defer resp.Body.Close()
+	pageParams["limit"] = "100"
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	var users []User
+	for offset := 0; ; {
+		pageParams["offset"] = fmt.Sprintf("%d", offset)
 
-	var usersResp UsersResponse
-	if err := json.Unmarshal(body, &usersResp); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		resp, err := c.makeRequest("GET", withParams("/users.json", pageParams))
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var usersResp UsersResponse
+		if err := json.Unmarshal(body, &usersResp); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		users = append(users, usersResp.Users...)
+		offset += len(usersResp.Users)
+		if len(usersResp.Users) == 0 || offset >= usersResp.TotalCount {
+			break
+		}
 	}
 
-	return &usersResp, nil
+	return users, nil
 }
 
 func (c *Client) GetCurrentUser() (*UserResponse, error) {
@@ -415,6 +668,27 @@ func (c *Client) GetTrackers() (*TrackersResponse, error) {
 	return &trackersResp, nil
 }
 
+// GetIssuePriorities retrieves the issue priority enumeration
+func (c *Client) GetIssuePriorities() (*IssuePrioritiesResponse, error) {
+	resp, err := c.makeRequest("GET", "/enumerations/issue_priorities.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var prioritiesResp IssuePrioritiesResponse
+	if err := json.Unmarshal(body, &prioritiesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &prioritiesResp, nil
+}
+
 // Search performs a search using the Redmine search API
 func (c *Client) Search(params map[string]string) (*SearchResponse, error) {
 	endpoint := "/search.json"
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// CustomFieldDefinition describes a custom field. The full definition is
+// only available to administrators; projects list the IDs and names of the
+// issue custom fields enabled for them.
+type CustomFieldDefinition struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	CustomizedType string          `json:"customized_type,omitempty"`
+	FieldFormat    string          `json:"field_format,omitempty"`
+	Regexp         string          `json:"regexp,omitempty"`
+	MinLength      *int            `json:"min_length,omitempty"`
+	MaxLength      *int            `json:"max_length,omitempty"`
+	IsRequired     bool            `json:"is_required,omitempty"`
+	IsFilter       bool            `json:"is_filter,omitempty"`
+	Searchable     bool            `json:"searchable,omitempty"`
+	Multiple       bool            `json:"multiple,omitempty"`
+	DefaultValue   *string         `json:"default_value,omitempty"`
+	Visible        *bool           `json:"visible,omitempty"`
+	PossibleValues []PossibleValue `json:"possible_values,omitempty"`
+	Trackers       []Tracker       `json:"trackers,omitempty"`
+	Roles          []Role          `json:"roles,omitempty"`
+}
+
+// PossibleValue is a choice of a list or key/value list custom field. For
+// key/value lists Value is the ID to send and Label the displayed name.
+type PossibleValue struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+}
+
+type CustomFieldsResponse struct {
+	CustomFields []CustomFieldDefinition `json:"custom_fields"`
+}
+
+// GetCustomFields retrieves every custom field definition (admin only)
+func (c *Client) GetCustomFields() (*CustomFieldsResponse, error) {
+	resp, err := c.makeRequest("GET", "/custom_fields.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var fieldsResp CustomFieldsResponse
+	if err := json.Unmarshal(body, &fieldsResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &fieldsResp, nil
+}
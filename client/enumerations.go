@@ -0,0 +1,58 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type IssueStatusesResponse struct {
+	IssueStatuses []Status `json:"issue_statuses"`
+}
+
+type IssueCategoriesResponse struct {
+	IssueCategories []IssueCategory `json:"issue_categories"`
+	TotalCount      int             `json:"total_count"`
+}
+
+// GetIssueStatuses retrieves every issue status
+func (c *Client) GetIssueStatuses() (*IssueStatusesResponse, error) {
+	resp, err := c.makeRequest("GET", "/issue_statuses.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var statusesResp IssueStatusesResponse
+	if err := json.Unmarshal(body, &statusesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &statusesResp, nil
+}
+
+// GetIssueCategories retrieves the issue categories of a project
+func (c *Client) GetIssueCategories(project string) (*IssueCategoriesResponse, error) {
+	resp, err := c.makeRequest("GET", projectPath(project)+"/issue_categories.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var categoriesResp IssueCategoriesResponse
+	if err := json.Unmarshal(body, &categoriesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &categoriesResp, nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UpdateJournalRequest represents the request body for editing the notes of
+// an issue journal (Redmine 5.0+)
+type UpdateJournalRequest struct {
+	Journal UpdateJournalData `json:"journal"`
+}
+
+// UpdateJournalData holds the changes to a journal. Empty notes clear the
+// comment; Redmine deletes a journal that is left without notes and changes.
+type UpdateJournalData struct {
+	Notes        *string `json:"notes,omitempty"`
+	PrivateNotes *bool   `json:"private_notes,omitempty"`
+}
+
+// UpdateJournal changes the notes of a journal
+func (c *Client) UpdateJournal(id int, req UpdateJournalRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", fmt.Sprintf("/journals/%d.json", id), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
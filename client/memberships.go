@@ -0,0 +1,182 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Group represents a user group
+type Group struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// Role represents a role that can be given to project members
+type Role struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// MembershipRole is a role of a membership. Inherited roles come from a
+// group or a parent project and cannot be removed from the membership.
+type MembershipRole struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Inherited bool   `json:"inherited,omitempty"`
+}
+
+// Membership represents a user or group that is a member of a project
+type Membership struct {
+	ID      int              `json:"id"`
+	Project Project          `json:"project"`
+	User    *User            `json:"user,omitempty"`
+	Group   *Group           `json:"group,omitempty"`
+	Roles   []MembershipRole `json:"roles"`
+}
+
+type MembershipsResponse struct {
+	Memberships []Membership `json:"memberships"`
+	TotalCount  int          `json:"total_count"`
+	Offset      int          `json:"offset"`
+	Limit       int          `json:"limit"`
+}
+
+type MembershipResponse struct {
+	Membership Membership `json:"membership"`
+}
+
+type RolesResponse struct {
+	Roles []Role `json:"roles"`
+}
+
+// CreateMembershipRequest represents the request body for adding a project member
+type CreateMembershipRequest struct {
+	Membership CreateMembershipData `json:"membership"`
+}
+
+// CreateMembershipData represents the data structure for adding a project
+// member. UserID may also be the ID of a group.
+type CreateMembershipData struct {
+	UserID  int   `json:"user_id"`
+	RoleIDs []int `json:"role_ids"`
+}
+
+// UpdateMembershipRequest represents the request body for changing the roles of a member
+type UpdateMembershipRequest struct {
+	Membership UpdateMembershipData `json:"membership"`
+}
+
+// UpdateMembershipData represents the data structure for changing the roles of a member
+type UpdateMembershipData struct {
+	RoleIDs []int `json:"role_ids"`
+}
+
+// GetAllMemberships pages through every membership of a project given its
+// ID or identifier
+func (c *Client) GetAllMemberships(project string) ([]Membership, error) {
+	params := map[string]string{"limit": "100"}
+
+	var memberships []Membership
+	for offset := 0; ; {
+		params["offset"] = fmt.Sprintf("%d", offset)
+
+		resp, err := c.makeRequest("GET", withParams(projectPath(project)+"/memberships.json", params))
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+
+		var membershipsResp MembershipsResponse
+		if err := json.Unmarshal(body, &membershipsResp); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+
+		memberships = append(memberships, membershipsResp.Memberships...)
+		offset += len(membershipsResp.Memberships)
+		if len(membershipsResp.Memberships) == 0 || offset >= membershipsResp.TotalCount {
+			break
+		}
+	}
+
+	return memberships, nil
+}
+
+// CreateMembership adds a user or group to a project
+func (c *Client) CreateMembership(project string, req CreateMembershipRequest) (*MembershipResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", projectPath(project)+"/memberships.json", jsonData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var membershipResp MembershipResponse
+	if err := json.Unmarshal(body, &membershipResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &membershipResp, nil
+}
+
+// UpdateMembership replaces the roles of a membership
+func (c *Client) UpdateMembership(id int, req UpdateMembershipRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", fmt.Sprintf("/memberships/%d.json", id), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// DeleteMembership removes a member from a project
+func (c *Client) DeleteMembership(id int) error {
+	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/memberships/%d.json", id))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// GetRoles retrieves the roles that can be given to project members
+func (c *Client) GetRoles() (*RolesResponse, error) {
+	resp, err := c.makeRequest("GET", "/roles.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var rolesResp RolesResponse
+	if err := json.Unmarshal(body, &rolesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &rolesResp, nil
+}
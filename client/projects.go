@@ -0,0 +1,223 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// Project status values
+const (
+	ProjectStatusActive   = 1
+	ProjectStatusClosed   = 5
+	ProjectStatusArchived = 9
+)
+
+// IssueCategory represents an issue category of a project
+type IssueCategory struct {
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Project    *Project `json:"project,omitempty"`
+	AssignedTo *User    `json:"assigned_to,omitempty"`
+}
+
+// EnabledModule represents a module enabled on a project
+type EnabledModule struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type ProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+// CreateProjectRequest represents the request body for creating a project
+type CreateProjectRequest struct {
+	Project CreateProjectData `json:"project"`
+}
+
+// CreateProjectData represents the data structure for creating a project
+type CreateProjectData struct {
+	Name               string   `json:"name"`
+	Identifier         string   `json:"identifier"`
+	Description        string   `json:"description,omitempty"`
+	Homepage           string   `json:"homepage,omitempty"`
+	IsPublic           *bool    `json:"is_public,omitempty"`
+	ParentID           int      `json:"parent_id,omitempty"`
+	InheritMembers     *bool    `json:"inherit_members,omitempty"`
+	TrackerIDs         []int    `json:"tracker_ids,omitempty"`
+	EnabledModuleNames []string `json:"enabled_module_names,omitempty"`
+}
+
+// UpdateProjectRequest represents the request body for updating a project
+type UpdateProjectRequest struct {
+	Project UpdateProjectData `json:"project"`
+}
+
+// UpdateProjectData represents the data structure for updating a project
+type UpdateProjectData struct {
+	Name               *string  `json:"name,omitempty"`
+	Description        *string  `json:"description,omitempty"`
+	Homepage           *string  `json:"homepage,omitempty"`
+	IsPublic           *bool    `json:"is_public,omitempty"`
+	ParentID           *int     `json:"parent_id,omitempty"`
+	InheritMembers     *bool    `json:"inherit_members,omitempty"`
+	TrackerIDs         []int    `json:"tracker_ids,omitempty"`
+	EnabledModuleNames []string `json:"enabled_module_names,omitempty"`
+}
+
+// projectPath returns the API path of a project given its ID or identifier
+func projectPath(id string) string {
+	return "/projects/" + url.PathEscape(id)
+}
+
+// GetProjects retrieves all projects visible to the user, paging through
+// the results
+func (c *Client) GetProjects() (*ProjectsResponse, error) {
+	projects, err := c.GetAllProjects(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectsResponse{Projects: projects, TotalCount: len(projects), Limit: len(projects)}, nil
+}
+
+// GetProjectsPage retrieves a page of projects matching params
+func (c *Client) GetProjectsPage(params map[string]string) (*ProjectsResponse, error) {
+	resp, err := c.makeRequest("GET", withParams("/projects.json", params))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var projectsResp ProjectsResponse
+	if err := json.Unmarshal(body, &projectsResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &projectsResp, nil
+}
+
+// GetAllProjects pages through every project matching params
+func (c *Client) GetAllProjects(params map[string]string) ([]Project, error) {
+	pageParams := make(map[string]string, len(params)+2)
+	for key, value := range params {
+		pageParams[key] = value
+	}
+	pageParams["limit"] = "100"
+
+	var projects []Project
+	for offset := 0; ; {
+		pageParams["offset"] = fmt.Sprintf("%d", offset)
+
+		projectsResp, err := c.GetProjectsPage(pageParams)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, projectsResp.Projects...)
+		offset += len(projectsResp.Projects)
+		if len(projectsResp.Projects) == 0 || offset >= projectsResp.TotalCount {
+			break
+		}
+	}
+
+	return projects, nil
+}
+
+// GetProject retrieves a project by ID or identifier
+func (c *Client) GetProject(id string, include ...string) (*ProjectResponse, error) {
+	endpoint := projectPath(id) + ".json"
+	if len(include) > 0 {
+		endpoint += "?include=" + strings.Join(include, ",")
+	}
+
+	resp, err := c.makeRequest("GET", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var projectResp ProjectResponse
+	if err := json.Unmarshal(body, &projectResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &projectResp, nil
+}
+
+// CreateProject creates a new project
+func (c *Client) CreateProject(req CreateProjectRequest) (*ProjectResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", "/projects.json", jsonData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var projectResp ProjectResponse
+	if err := json.Unmarshal(body, &projectResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &projectResp, nil
+}
+
+// UpdateProject updates a project given its ID or identifier
+func (c *Client) UpdateProject(id string, req UpdateProjectRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", projectPath(id)+".json", jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// ArchiveProject archives a project (Redmine 5.0 or later)
+func (c *Client) ArchiveProject(id string) error {
+	resp, err := c.makeRequest("PUT", projectPath(id)+"/archive.json")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// UnarchiveProject unarchives a project (Redmine 5.0 or later)
+func (c *Client) UnarchiveProject(id string) error {
+	resp, err := c.makeRequest("PUT", projectPath(id)+"/unarchive.json")
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Query represents a saved issue query. ProjectID is nil for queries
+// available in every project.
+type Query struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	IsPublic  bool   `json:"is_public"`
+	ProjectID *int   `json:"project_id"`
+}
+
+type QueriesResponse struct {
+	Queries    []Query `json:"queries"`
+	TotalCount int     `json:"total_count"`
+	Offset     int     `json:"offset"`
+	Limit      int     `json:"limit"`
+}
+
+// GetQueries retrieves a page of the saved queries visible to the user
+func (c *Client) GetQueries(params map[string]string) (*QueriesResponse, error) {
+	resp, err := c.makeRequest("GET", withParams("/queries.json", params))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var queriesResp QueriesResponse
+	if err := json.Unmarshal(body, &queriesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &queriesResp, nil
+}
+
+// GetAllQueries pages through every saved query visible to the user
+func (c *Client) GetAllQueries() ([]Query, error) {
+	params := map[string]string{"limit": "100"}
+
+	var queries []Query
+	for offset := 0; ; {
+		params["offset"] = fmt.Sprintf("%d", offset)
+
+		queriesResp, err := c.GetQueries(params)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, queriesResp.Queries...)
+		offset += len(queriesResp.Queries)
+		if len(queriesResp.Queries) == 0 || offset >= queriesResp.TotalCount {
+			break
+		}
+	}
+
+	return queries, nil
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// RelationTypes lists the relation types accepted by Redmine. The reverse
+// types (blocked, follows, ...) are stored as their forward type with the
+// issues swapped.
+var RelationTypes = []string{
+	"relates", "duplicates", "duplicated", "blocks", "blocked",
+	"precedes", "follows", "copied_to", "copied_from",
+}
+
+type RelationsResponse struct {
+	Relations []Relation `json:"relations"`
+}
+
+type RelationResponse struct {
+	Relation Relation `json:"relation"`
+}
+
+// CreateRelationRequest represents the request body for creating a relation
+type CreateRelationRequest struct {
+	Relation CreateRelationData `json:"relation"`
+}
+
+// CreateRelationData represents the data structure for creating a relation.
+// Delay is only used by precedes and follows relations.
+type CreateRelationData struct {
+	IssueToID    int    `json:"issue_to_id"`
+	RelationType string `json:"relation_type"`
+	Delay        *int   `json:"delay,omitempty"`
+}
+
+// GetRelations retrieves the relations of an issue
+func (c *Client) GetRelations(issueID int) (*RelationsResponse, error) {
+	resp, err := c.makeRequest("GET", fmt.Sprintf("/issues/%d/relations.json", issueID))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var relationsResp RelationsResponse
+	if err := json.Unmarshal(body, &relationsResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &relationsResp, nil
+}
+
+// CreateRelation creates a relation from an issue to another issue
+func (c *Client) CreateRelation(issueID int, req CreateRelationRequest) (*RelationResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", fmt.Sprintf("/issues/%d/relations.json", issueID), jsonData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var relationResp RelationResponse
+	if err := json.Unmarshal(body, &relationResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &relationResp, nil
+}
+
+// DeleteRelation deletes a relation
+func (c *Client) DeleteRelation(id int) error {
+	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/relations/%d.json", id))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// IssueRef is a reference to an issue by ID as embedded in other resources
+type IssueRef struct {
+	ID int `json:"id"`
+}
+
+// TimeEntry represents time spent on an issue or project
+type TimeEntry struct {
+	ID        int               `json:"id"`
+	Project   Project           `json:"project"`
+	Issue     *IssueRef         `json:"issue,omitempty"`
+	User      User              `json:"user"`
+	Activity  TimeEntryActivity `json:"activity"`
+	Hours     float64           `json:"hours"`
+	Comments  string            `json:"comments"`
+	SpentOn   string            `json:"spent_on"`
+	CreatedOn time.Time         `json:"created_on"`
+	UpdatedOn time.Time         `json:"updated_on"`
+}
+
+// TimeEntryActivity represents an entry of the time entry activity enumeration
+type TimeEntryActivity struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"is_default,omitempty"`
+	Active    *bool  `json:"active,omitempty"`
+}
+
+type TimeEntriesResponse struct {
+	TimeEntries []TimeEntry `json:"time_entries"`
+	TotalCount  int         `json:"total_count"`
+	Offset      int         `json:"offset"`
+	Limit       int         `json:"limit"`
+}
+
+type TimeEntryResponse struct {
+	TimeEntry TimeEntry `json:"time_entry"`
+}
+
+type TimeEntryActivitiesResponse struct {
+	TimeEntryActivities []TimeEntryActivity `json:"time_entry_activities"`
+}
+
+// CreateTimeEntryRequest represents the request body for creating a time entry
+type CreateTimeEntryRequest struct {
+	TimeEntry CreateTimeEntryData `json:"time_entry"`
+}
+
+// CreateTimeEntryData represents the data structure for creating a time entry.
+// Either IssueID or ProjectID must be set.
+type CreateTimeEntryData struct {
+	IssueID    int     `json:"issue_id,omitempty"`
+	ProjectID  int     `json:"project_id,omitempty"`
+	SpentOn    string  `json:"spent_on,omitempty"`
+	Hours      float64 `json:"hours"`
+	ActivityID int     `json:"activity_id,omitempty"`
+	Comments   string  `json:"comments,omitempty"`
+	UserID     int     `json:"user_id,omitempty"`
+}
+
+// UpdateTimeEntryRequest represents the request body for updating a time entry
+type UpdateTimeEntryRequest struct {
+	TimeEntry UpdateTimeEntryData `json:"time_entry"`
+}
+
+// UpdateTimeEntryData represents the data structure for updating a time entry
+type UpdateTimeEntryData struct {
+	IssueID    *int     `json:"issue_id,omitempty"`
+	SpentOn    *string  `json:"spent_on,omitempty"`
+	Hours      *float64 `json:"hours,omitempty"`
+	ActivityID *int     `json:"activity_id,omitempty"`
+	Comments   *string  `json:"comments,omitempty"`
+}
+
+// GetTimeEntries retrieves a page of time entries matching params
+func (c *Client) GetTimeEntries(params map[string]string) (*TimeEntriesResponse, error) {
+	resp, err := c.makeRequest("GET", withParams("/time_entries.json", params))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var entriesResp TimeEntriesResponse
+	if err := json.Unmarshal(body, &entriesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &entriesResp, nil
+}
+
+// GetAllTimeEntries pages through every time entry matching params
+func (c *Client) GetAllTimeEntries(params map[string]string) ([]TimeEntry, error) {
+	pageParams := make(map[string]string, len(params)+2)
+	for key, value := range params {
+		pageParams[key] = value
+	}
+	pageParams["limit"] = "100"
+
+	var entries []TimeEntry
+	for offset := 0; ; {
+		pageParams["offset"] = fmt.Sprintf("%d", offset)
+
+		entriesResp, err := c.GetTimeEntries(pageParams)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entriesResp.TimeEntries...)
+		offset += len(entriesResp.TimeEntries)
+		if len(entriesResp.TimeEntries) == 0 || offset >= entriesResp.TotalCount {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// GetTimeEntry retrieves a single time entry
+func (c *Client) GetTimeEntry(id int) (*TimeEntryResponse, error) {
+	resp, err := c.makeRequest("GET", fmt.Sprintf("/time_entries/%d.json", id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var entryResp TimeEntryResponse
+	if err := json.Unmarshal(body, &entryResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &entryResp, nil
+}
+
+// CreateTimeEntry creates a new time entry
+func (c *Client) CreateTimeEntry(req CreateTimeEntryRequest) (*TimeEntryResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", "/time_entries.json", jsonData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var entryResp TimeEntryResponse
+	if err := json.Unmarshal(body, &entryResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &entryResp, nil
+}
+
+// UpdateTimeEntry updates an existing time entry
+func (c *Client) UpdateTimeEntry(id int, req UpdateTimeEntryRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", fmt.Sprintf("/time_entries/%d.json", id), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// DeleteTimeEntry deletes a time entry
+func (c *Client) DeleteTimeEntry(id int) error {
+	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/time_entries/%d.json", id))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// GetTimeEntryActivities retrieves the time entry activity enumeration
+func (c *Client) GetTimeEntryActivities() (*TimeEntryActivitiesResponse, error) {
+	resp, err := c.makeRequest("GET", "/enumerations/time_entry_activities.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var activitiesResp TimeEntryActivitiesResponse
+	if err := json.Unmarshal(body, &activitiesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &activitiesResp, nil
+}
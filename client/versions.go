@@ -0,0 +1,148 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Version represents a project version (milestone)
+type Version struct {
+	ID             int        `json:"id"`
+	Name           string     `json:"name"`
+	Project        *Project   `json:"project,omitempty"`
+	Description    string     `json:"description,omitempty"`
+	Status         string     `json:"status,omitempty"`
+	DueDate        *string    `json:"due_date,omitempty"`
+	Sharing        string     `json:"sharing,omitempty"`
+	WikiPageTitle  string     `json:"wiki_page_title,omitempty"`
+	EstimatedHours *float64   `json:"estimated_hours,omitempty"`
+	SpentHours     *float64   `json:"spent_hours,omitempty"`
+	CreatedOn      *time.Time `json:"created_on,omitempty"`
+	UpdatedOn      *time.Time `json:"updated_on,omitempty"`
+}
+
+type VersionsResponse struct {
+	Versions   []Version `json:"versions"`
+	TotalCount int       `json:"total_count"`
+}
+
+type VersionResponse struct {
+	Version Version `json:"version"`
+}
+
+// CreateVersionRequest represents the request body for creating a version
+type CreateVersionRequest struct {
+	Version CreateVersionData `json:"version"`
+}
+
+// CreateVersionData represents the data structure for creating a version
+type CreateVersionData struct {
+	Name          string `json:"name"`
+	Status        string `json:"status,omitempty"`
+	Sharing       string `json:"sharing,omitempty"`
+	DueDate       string `json:"due_date,omitempty"`
+	Description   string `json:"description,omitempty"`
+	WikiPageTitle string `json:"wiki_page_title,omitempty"`
+}
+
+// UpdateVersionRequest represents the request body for updating a version
+type UpdateVersionRequest struct {
+	Version UpdateVersionData `json:"version"`
+}
+
+// UpdateVersionData represents the data structure for updating a version
+type UpdateVersionData struct {
+	Name          *string `json:"name,omitempty"`
+	Status        *string `json:"status,omitempty"`
+	Sharing       *string `json:"sharing,omitempty"`
+	DueDate       *string `json:"due_date,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	WikiPageTitle *string `json:"wiki_page_title,omitempty"`
+}
+
+// GetVersions retrieves the versions available to a project given its ID or
+// identifier, including versions shared from other projects
+func (c *Client) GetVersions(project string) (*VersionsResponse, error) {
+	resp, err := c.makeRequest("GET", projectPath(project)+"/versions.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var versionsResp VersionsResponse
+	if err := json.Unmarshal(body, &versionsResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &versionsResp, nil
+}
+
+// GetVersion retrieves a single version
+func (c *Client) GetVersion(id int) (*VersionResponse, error) {
+	resp, err := c.makeRequest("GET", fmt.Sprintf("/versions/%d.json", id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var versionResp VersionResponse
+	if err := json.Unmarshal(body, &versionResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &versionResp, nil
+}
+
+// CreateVersion creates a version in a project given its ID or identifier
+func (c *Client) CreateVersion(project string, req CreateVersionRequest) (*VersionResponse, error) {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", projectPath(project)+"/versions.json", jsonData)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var versionResp VersionResponse
+	if err := json.Unmarshal(body, &versionResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &versionResp, nil
+}
+
+// UpdateVersion updates an existing version
+func (c *Client) UpdateVersion(id int, req UpdateVersionRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", fmt.Sprintf("/versions/%d.json", id), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AddWatcherRequest represents the request body for adding a watcher to an issue
+type AddWatcherRequest struct {
+	UserID int `json:"user_id"`
+}
+
+// AddWatcher adds a user to the watchers of an issue
+func (c *Client) AddWatcher(issueID, userID int) error {
+	jsonData, err := json.Marshal(AddWatcherRequest{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("POST", fmt.Sprintf("/issues/%d/watchers.json", issueID), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// RemoveWatcher removes a user from the watchers of an issue
+func (c *Client) RemoveWatcher(issueID, userID int) error {
+	resp, err := c.makeRequest("DELETE", fmt.Sprintf("/issues/%d/watchers/%d.json", issueID, userID))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// WikiPage represents a page of a project wiki. The index only returns the
+// title, parent, version and dates.
+type WikiPage struct {
+	Title       string          `json:"title"`
+	Parent      *WikiPageParent `json:"parent,omitempty"`
+	Text        string          `json:"text,omitempty"`
+	Version     int             `json:"version"`
+	Author      *User           `json:"author,omitempty"`
+	Comments    string          `json:"comments,omitempty"`
+	CreatedOn   *time.Time      `json:"created_on,omitempty"`
+	UpdatedOn   *time.Time      `json:"updated_on,omitempty"`
+	Attachments []Attachment    `json:"attachments,omitempty"`
+}
+
+// WikiPageParent is the parent of a wiki page
+type WikiPageParent struct {
+	Title string `json:"title"`
+}
+
+type WikiPagesResponse struct {
+	WikiPages []WikiPage `json:"wiki_pages"`
+}
+
+type WikiPageResponse struct {
+	WikiPage WikiPage `json:"wiki_page"`
+}
+
+// SaveWikiPageRequest represents the request body for creating or updating a wiki page
+type SaveWikiPageRequest struct {
+	WikiPage SaveWikiPageData `json:"wiki_page"`
+}
+
+// SaveWikiPageData represents the data structure for creating or updating a
+// wiki page. When Version is set, Redmine refuses the update with 409
+// Conflict if the page was changed since that version.
+type SaveWikiPageData struct {
+	Text        string   `json:"text"`
+	Comments    string   `json:"comments,omitempty"`
+	Version     int      `json:"version,omitempty"`
+	ParentTitle string   `json:"parent_title,omitempty"`
+	Uploads     []Upload `json:"uploads,omitempty"`
+}
+
+// wikiPagePath returns the API path of a wiki page, optionally at a version
+func wikiPagePath(project, title string, version int) string {
+	path := projectPath(project) + "/wiki/" + url.PathEscape(title)
+	if version > 0 {
+		path += fmt.Sprintf("/%d", version)
+	}
+	return path + ".json"
+}
+
+// GetWikiPages retrieves the index of the wiki of a project given its ID or identifier
+func (c *Client) GetWikiPages(project string) (*WikiPagesResponse, error) {
+	resp, err := c.makeRequest("GET", projectPath(project)+"/wiki/index.json")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var pagesResp WikiPagesResponse
+	if err := json.Unmarshal(body, &pagesResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &pagesResp, nil
+}
+
+// GetWikiPage retrieves a wiki page, or an old version of it when version
+// is greater than 0
+func (c *Client) GetWikiPage(project, title string, version int, include ...string) (*WikiPageResponse, error) {
+	endpoint := wikiPagePath(project, title, version)
+	if len(include) > 0 {
+		endpoint += "?include=" + strings.Join(include, ",")
+	}
+
+	resp, err := c.makeRequest("GET", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var pageResp WikiPageResponse
+	if err := json.Unmarshal(body, &pageResp); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return &pageResp, nil
+}
+
+// SaveWikiPage creates a wiki page or updates an existing one
+func (c *Client) SaveWikiPage(project, title string, req SaveWikiPageRequest) error {
+	jsonData, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.makeRequest("PUT", wikiPagePath(project, title, 0), jsonData)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
+
+// DeleteWikiPage deletes a wiki page with its history. Redmine keeps the
+// child pages and makes them top-level pages.
+func (c *Client) DeleteWikiPage(project, title string) error {
+	resp, err := c.makeRequest("DELETE", wikiPagePath(project, title, 0))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	return nil
+}
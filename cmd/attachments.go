@@ -0,0 +1,320 @@
+package cmd
+
+import (
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var attachmentsCmd = &cobra.Command{
+	Use:   "attachments",
+	Short: "Manage issue attachments",
+	Long:  `List, download and delete files attached to Redmine issues`,
+}
+
+var listAttachmentsCmd = &cobra.Command{
+	Use:   "list [issue_id]",
+	Short: "List attachments of an issue",
+	Long:  `List all files attached to an issue`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetIssue(issueID, "attachments")
+		if err != nil {
+			fmt.Printf("Error getting issue: %v\n", err)
+			return
+		}
+
+		attachments := response.Issue.Attachments
+		if len(attachments) == 0 {
+			fmt.Println("No attachments found.")
+			return
+		}
+
+		fmt.Printf("Attachments of #%d (Total: %d)\n", issueID, len(attachments))
+		fmt.Println(strings.Repeat("-", 80))
+
+		for _, attachment := range attachments {
+			fmt.Printf("ID: %d | %s | %s | %s | %s\n",
+				attachment.ID,
+				attachment.Filename,
+				formatFileSize(attachment.Filesize),
+				attachment.Author.Name,
+				attachment.CreatedOn.Format("2006-01-02 15:04:05"))
+			if attachment.Description != "" {
+				fmt.Printf("    %s\n", attachment.Description)
+			}
+		}
+	},
+}
+
+var downloadAttachmentsCmd = &cobra.Command{
+	Use:   "download [issue_id]",
+	Short: "Download attachments of an issue",
+	Long: `Download all attachments of an issue (or only those selected with --id) into a directory.
+
+When several attachments have the same name, the later ones are saved with
+their attachment ID added, e.g. image_42.png.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		dir, _ := cmd.Flags().GetString("dir")
+		ids, _ := cmd.Flags().GetIntSlice("id")
+		force, _ := cmd.Flags().GetBool("force")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetIssue(issueID, "attachments")
+		if err != nil {
+			fmt.Printf("Error getting issue: %v\n", err)
+			return
+		}
+
+		selected := make(map[int]bool)
+		for _, id := range ids {
+			selected[id] = true
+		}
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			fmt.Printf("Error creating directory: %v\n", err)
+			return
+		}
+
+		downloaded := 0
+		taken := make(map[string]bool)
+		for _, attachment := range response.Issue.Attachments {
+			if len(selected) > 0 && !selected[attachment.ID] {
+				continue
+			}
+
+			path := filepath.Join(dir, attachmentFileName(attachment, taken))
+			if _, err := os.Stat(path); err == nil && !force {
+				fmt.Printf("Skipping %s: file already exists (use --force to overwrite)\n", path)
+				continue
+			}
+
+			written, err := downloadAttachmentTo(c, attachment, path)
+			if err != nil {
+				fmt.Printf("Error downloading %s: %v\n", attachment.Filename, err)
+				continue
+			}
+			downloaded++
+			fmt.Printf("Downloaded %s (%s)\n", path, formatFileSize(written))
+		}
+
+		if downloaded == 0 {
+			fmt.Println("No attachments downloaded.")
+		}
+	},
+}
+
+var deleteAttachmentsCmd = &cobra.Command{
+	Use:   "delete [attachment_id...]",
+	Short: "Delete attachments",
+	Long:  `Delete one or more attachments by ID (see 'attachments list')`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		for _, arg := range args {
+			attachmentID, err := strconv.Atoi(arg)
+			if err != nil {
+				fmt.Printf("Invalid attachment ID: %s\n", arg)
+				continue
+			}
+
+			if err := c.DeleteAttachment(attachmentID); err != nil {
+				fmt.Printf("Error deleting attachment %d: %v\n", attachmentID, err)
+				continue
+			}
+			fmt.Printf("Attachment %d deleted\n", attachmentID)
+		}
+	},
+}
+
+// attachmentFileName returns the local file name of an attachment. Names
+// are stripped of directories, and a name already taken gets the
+// attachment ID added, e.g. "image_42.png", so that attachments with the
+// same name do not overwrite each other.
+func attachmentFileName(attachment client.Attachment, taken map[string]bool) string {
+	name := filepath.Base(attachment.Filename)
+	if name == "." || name == ".." || name == string(filepath.Separator) {
+		name = fmt.Sprintf("attachment_%d", attachment.ID)
+	}
+	if taken[name] {
+		ext := filepath.Ext(name)
+		name = fmt.Sprintf("%s_%d%s", strings.TrimSuffix(name, ext), attachment.ID, ext)
+	}
+	taken[name] = true
+	return name
+}
+
+// downloadAttachmentTo streams an attachment into path through a temporary
+// file so an interrupted download never leaves a truncated file behind
+func downloadAttachmentTo(c *client.Client, attachment client.Attachment, path string) (int64, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := c.DownloadAttachment(attachment, tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return written, err
+	}
+
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return written, err
+	}
+	return written, os.Rename(tmp.Name(), path)
+}
+
+// uploadAttachments uploads local files and returns the upload tokens to
+// attach them on issue create or update
+func uploadAttachments(c *client.Client, paths []string) ([]client.Upload, error) {
+	var uploads []client.Upload
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+
+		filename := filepath.Base(path)
+		fmt.Printf("Uploading %s (%s)...\n", filename, formatFileSize(info.Size()))
+		response, err := c.UploadFile(filename, file, info.Size())
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		contentType, _, _ := strings.Cut(mime.TypeByExtension(filepath.Ext(filename)), ";")
+		uploads = append(uploads, client.Upload{
+			Token:       response.Upload.Token,
+			Filename:    filename,
+			ContentType: contentType,
+		})
+	}
+	return uploads, nil
+}
+
+func formatFileSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+func init() {
+	rootCmd.AddCommand(attachmentsCmd)
+	attachmentsCmd.AddCommand(listAttachmentsCmd)
+	attachmentsCmd.AddCommand(downloadAttachmentsCmd)
+	attachmentsCmd.AddCommand(deleteAttachmentsCmd)
+
+	downloadAttachmentsCmd.Flags().StringP("dir", "d", ".", "Directory to save attachments to")
+	downloadAttachmentsCmd.Flags().IntSlice("id", nil, "Only download attachments with these IDs")
+	downloadAttachmentsCmd.Flags().Bool("force", false, "Overwrite existing files")
+}
@@ -0,0 +1,432 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var customFieldsCmd = &cobra.Command{
+	Use:   "custom-fields [project]",
+	Short: "List issue custom fields",
+	Long: `List the issue custom fields with their format, trackers and possible values.
+
+Listing every field requires admin rights. Given a project, the fields enabled
+for the project are listed; without admin rights only their IDs and names are
+available.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		project := ""
+		if len(args) > 0 {
+			project = args[0]
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		fields, err := newCustomFieldResolver(c).definitions(project)
+		if err != nil {
+			fmt.Printf("Error getting custom fields: %v\n", err)
+			return
+		}
+
+		if len(fields) == 0 && format == "table" {
+			fmt.Println("No custom fields found.")
+			return
+		}
+
+		header := []string{"ID", "Format", "Multiple", "Required", "Trackers", "Values", "Name"}
+		rows := make([][]string, 0, len(fields))
+		for _, field := range fields {
+			var trackers, values []string
+			for _, tracker := range field.Trackers {
+				trackers = append(trackers, tracker.Name)
+			}
+			for _, value := range field.PossibleValues {
+				if value.Label != "" {
+					values = append(values, value.Label)
+				} else {
+					values = append(values, value.Value)
+				}
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(field.ID),
+				field.FieldFormat,
+				formatYesNo(field.Multiple),
+				formatYesNo(field.IsRequired),
+				strings.Join(trackers, ", "),
+				truncateString(strings.Join(values, ", "), 40),
+				field.Name,
+			})
+		}
+
+		if format == "table" {
+			fmt.Printf("Custom fields (Total: %d)\n", len(fields))
+		}
+		if err := writeOutput(format, header, rows, fields); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+// customFieldResolver looks up issue custom fields by name or ID and
+// converts values to what Redmine expects for the field format. Full
+// definitions come from /custom_fields.json for admins; other users only
+// get the IDs and names of the fields enabled for a project, and values are
+// then sent as given.
+type customFieldResolver struct {
+	c        *client.Client
+	loaded   bool
+	admin    []client.CustomFieldDefinition
+	isAdmin  bool
+	projects map[string][]client.CustomFieldDefinition
+	multiple map[string]map[int]bool
+	users    *userResolver
+}
+
+func newCustomFieldResolver(c *client.Client) *customFieldResolver {
+	return &customFieldResolver{
+		c:        c,
+		projects: make(map[string][]client.CustomFieldDefinition),
+		multiple: make(map[string]map[int]bool),
+		users:    newUserResolver(c),
+	}
+}
+
+// definitions returns the issue custom fields of a project, or every issue
+// custom field when project is empty (admin only)
+func (r *customFieldResolver) definitions(project string) ([]client.CustomFieldDefinition, error) {
+	if !r.loaded {
+		r.loaded = true
+		response, err := r.c.GetCustomFields()
+		if err != nil && !client.IsForbidden(err) {
+			return nil, fmt.Errorf("failed to get custom fields: %w", err)
+		}
+		if err == nil {
+			r.isAdmin = true
+			for _, field := range response.CustomFields {
+				if field.CustomizedType == "issue" {
+					r.admin = append(r.admin, field)
+				}
+			}
+		}
+	}
+
+	if project == "" {
+		if !r.isAdmin {
+			return nil, fmt.Errorf("listing every custom field requires admin rights; give a project to list its fields")
+		}
+		return r.admin, nil
+	}
+
+	enabled, ok := r.projects[project]
+	if !ok {
+		response, err := r.c.GetProject(project, "issue_custom_fields")
+		if err != nil {
+			return nil, fmt.Errorf("failed to get custom fields of %s: %w", project, err)
+		}
+		enabled = response.Project.IssueCustomFields
+		r.projects[project] = enabled
+	}
+	if !r.isAdmin {
+		return enabled, nil
+	}
+
+	// Use the full definitions of the fields enabled for the project
+	var fields []client.CustomFieldDefinition
+	for _, field := range r.admin {
+		for _, e := range enabled {
+			if e.ID == field.ID {
+				fields = append(fields, field)
+				break
+			}
+		}
+	}
+	return fields, nil
+}
+
+// find looks up a custom field of the project by ID or name. Numeric IDs
+// that are not listed are passed through as is.
+func (r *customFieldResolver) find(project, name string) (client.CustomFieldDefinition, error) {
+	fields, err := r.definitions(project)
+	if err != nil {
+		return client.CustomFieldDefinition{}, err
+	}
+
+	var names []string
+	for _, field := range fields {
+		if strconv.Itoa(field.ID) == name || strings.EqualFold(field.Name, name) {
+			return field, nil
+		}
+		names = append(names, field.Name)
+	}
+	if id, err := strconv.Atoi(name); err == nil {
+		return client.CustomFieldDefinition{ID: id}, nil
+	}
+	return client.CustomFieldDefinition{}, fmt.Errorf("custom field '%s' not found (available: %s)", name, joinOrNone(names))
+}
+
+// resolve converts "Name=Value" assignments for an issue of the project and
+// tracker. current holds the issue's custom fields when editing; they tell
+// which fields take multiple values when the definitions are not available.
+// Assignments repeated for the same field are combined into a list.
+func (r *customFieldResolver) resolve(project string, trackerID int, current []client.CustomField, assignments []string) ([]client.CustomFieldValue, error) {
+	var values []client.CustomFieldValue
+	for _, assignment := range assignments {
+		name, raw, ok := strings.Cut(assignment, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid custom field '%s' (expected Name=Value)", assignment)
+		}
+		name = strings.TrimSpace(name)
+		value, err := r.value(project, trackerID, current, name, raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if i := customFieldValueIndex(values, value.ID); i >= 0 {
+			field, _ := r.find(project, name)
+			if field.FieldFormat != "" && !field.Multiple {
+				return nil, fmt.Errorf("custom field '%s' takes a single value", field.Name)
+			}
+			values[i].Value = append(customFieldValueList(values[i].Value), customFieldValueList(value.Value)...)
+			continue
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// value converts a single custom field value. Multi-value fields take a
+// comma-separated list; an empty value clears the field. Without the
+// definitions, a field takes multiple values when current or the project's
+// issues say so.
+func (r *customFieldResolver) value(project string, trackerID int, current []client.CustomField, name, raw string) (client.CustomFieldValue, error) {
+	field, err := r.find(project, name)
+	if err != nil {
+		return client.CustomFieldValue{}, err
+	}
+
+	if trackerID != 0 && len(field.Trackers) > 0 {
+		enabled := false
+		for _, tracker := range field.Trackers {
+			enabled = enabled || tracker.ID == trackerID
+		}
+		if !enabled {
+			return client.CustomFieldValue{}, fmt.Errorf("custom field '%s' is not used by the issue's tracker", field.Name)
+		}
+	}
+
+	multiple := field.Multiple
+	for _, cf := range current {
+		if cf.ID == field.ID && cf.Multiple {
+			multiple = true
+		}
+	}
+	if !multiple && field.FieldFormat == "" {
+		multiple = r.multipleFields(project)[field.ID]
+	}
+
+	if !multiple {
+		value, err := r.convert(project, field, strings.TrimSpace(raw))
+		return client.CustomFieldValue{ID: field.ID, Value: value}, err
+	}
+
+	values := []string{}
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		value, err := r.convert(project, field, part)
+		if err != nil {
+			return client.CustomFieldValue{}, err
+		}
+		values = append(values, value)
+	}
+	return client.CustomFieldValue{ID: field.ID, Value: values}, nil
+}
+
+// multipleFields returns the IDs of the multi-value custom fields used by
+// the project's recent issues, for users who cannot read the definitions.
+// Errors leave the fields unknown.
+func (r *customFieldResolver) multipleFields(project string) map[int]bool {
+	if ids, ok := r.multiple[project]; ok {
+		return ids
+	}
+
+	ids := make(map[int]bool)
+	response, err := r.c.GetIssues(map[string]string{"project_id": project, "status_id": "*", "limit": "100"})
+	if err == nil {
+		for _, issue := range response.Issues {
+			for _, field := range issue.CustomFields {
+				if field.Multiple {
+					ids[field.ID] = true
+				}
+			}
+		}
+	}
+	r.multiple[project] = ids
+	return ids
+}
+
+// convert checks a value against the field format and returns the value to
+// send: list choices are matched case-insensitively, key/value lists, users
+// and versions are sent as IDs, booleans as 1/0 and dates as YYYY-MM-DD
+func (r *customFieldResolver) convert(project string, field client.CustomFieldDefinition, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	switch field.FieldFormat {
+	case "bool":
+		switch strings.ToLower(value) {
+		case "1", "yes", "true", "on":
+			return "1", nil
+		case "0", "no", "false", "off":
+			return "0", nil
+		}
+		return "", fmt.Errorf("custom field '%s' expects yes or no, got '%s'", field.Name, value)
+	case "date":
+		date, err := parseDateArg(value, time.Now())
+		if err != nil {
+			return "", fmt.Errorf("custom field '%s': %w", field.Name, err)
+		}
+		return date, nil
+	case "int":
+		if _, err := strconv.Atoi(value); err != nil {
+			return "", fmt.Errorf("custom field '%s' expects an integer, got '%s'", field.Name, value)
+		}
+	case "float":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("custom field '%s' expects a number, got '%s'", field.Name, value)
+		}
+	case "list", "enumeration":
+		if len(field.PossibleValues) == 0 {
+			return value, nil
+		}
+		var choices []string
+		for _, choice := range field.PossibleValues {
+			label := choice.Label
+			if label == "" {
+				label = choice.Value
+			}
+			if strings.EqualFold(choice.Value, value) || strings.EqualFold(label, value) {
+				return choice.Value, nil
+			}
+			choices = append(choices, label)
+		}
+		return "", fmt.Errorf("'%s' is not a value of custom field '%s' (available: %s)", value, field.Name, strings.Join(choices, ", "))
+	case "user":
+		id, err := r.users.resolve(project, value)
+		if err != nil {
+			return "", fmt.Errorf("custom field '%s': %w", field.Name, err)
+		}
+		return strconv.Itoa(id), nil
+	case "version":
+		version, err := findVersion(r.c, project, value)
+		if err != nil {
+			return "", fmt.Errorf("custom field '%s': %w", field.Name, err)
+		}
+		return strconv.Itoa(version.ID), nil
+	}
+	return value, nil
+}
+
+// templateCustomFieldAssignments turns custom fields keyed by name or ID into
+// "Name=Value" assignments in a stable order
+func templateCustomFieldAssignments(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	assignments := make([]string, 0, len(keys))
+	for _, key := range keys {
+		assignments = append(assignments, key+"="+fields[key])
+	}
+	return assignments
+}
+
+// customFieldValueIndex returns the position of the field's value, or -1
+func customFieldValueIndex(values []client.CustomFieldValue, id int) int {
+	for i := range values {
+		if values[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// customFieldValueList returns a converted value as a list; an empty value
+// is an empty list
+func customFieldValueList(value interface{}) []string {
+	switch v := value.(type) {
+	case []string:
+		return v
+	case string:
+		if v == "" {
+			return []string{}
+		}
+		return []string{v}
+	}
+	return []string{}
+}
+
+// mergeCustomFieldValues adds value, replacing an earlier value of the same field
+func mergeCustomFieldValues(values []client.CustomFieldValue, value client.CustomFieldValue) []client.CustomFieldValue {
+	for i := range values {
+		if values[i].ID == value.ID {
+			values[i] = value
+			return values
+		}
+	}
+	return append(values, value)
+}
+
+func formatYesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func init() {
+	rootCmd.AddCommand(customFieldsCmd)
+
+	customFieldsCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+}
@@ -0,0 +1,194 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// enumerationOutput is a lookup list ready for writeOutput
+type enumerationOutput struct {
+	header []string
+	rows   [][]string
+	data   interface{}
+}
+
+var statusesCmd = &cobra.Command{
+	Use:   "statuses",
+	Short: "List issue statuses",
+	Long:  `List the issue statuses with their IDs and whether they close an issue`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runEnumeration(cmd, "Issue statuses", func(c *client.Client) (enumerationOutput, error) {
+			response, err := c.GetIssueStatuses()
+			if err != nil {
+				return enumerationOutput{}, err
+			}
+			out := enumerationOutput{header: []string{"ID", "Closed", "Name"}, data: response.IssueStatuses}
+			for _, status := range response.IssueStatuses {
+				out.rows = append(out.rows, []string{strconv.Itoa(status.ID), formatYesNo(status.IsClosed), status.Name})
+			}
+			return out, nil
+		})
+	},
+}
+
+var trackersCmd = &cobra.Command{
+	Use:   "trackers",
+	Short: "List trackers",
+	Long:  `List the trackers with their IDs and default status`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runEnumeration(cmd, "Trackers", func(c *client.Client) (enumerationOutput, error) {
+			response, err := c.GetTrackers()
+			if err != nil {
+				return enumerationOutput{}, err
+			}
+			out := enumerationOutput{header: []string{"ID", "Default Status", "Name"}, data: response.Trackers}
+			for _, tracker := range response.Trackers {
+				defaultStatus := ""
+				if tracker.DefaultStatus != nil {
+					defaultStatus = tracker.DefaultStatus.Name
+				}
+				out.rows = append(out.rows, []string{strconv.Itoa(tracker.ID), defaultStatus, tracker.Name})
+			}
+			return out, nil
+		})
+	},
+}
+
+var prioritiesCmd = &cobra.Command{
+	Use:   "priorities",
+	Short: "List issue priorities",
+	Long:  `List the issue priorities with their IDs and the default priority`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runEnumeration(cmd, "Issue priorities", func(c *client.Client) (enumerationOutput, error) {
+			response, err := c.GetIssuePriorities()
+			if err != nil {
+				return enumerationOutput{}, err
+			}
+			out := enumerationOutput{header: []string{"ID", "Default", "Active", "Name"}, data: response.IssuePriorities}
+			for _, priority := range response.IssuePriorities {
+				out.rows = append(out.rows, []string{
+					strconv.Itoa(priority.ID),
+					formatYesNo(priority.IsDefault),
+					formatYesNo(priority.Active == nil || *priority.Active),
+					priority.Name,
+				})
+			}
+			return out, nil
+		})
+	},
+}
+
+var activitiesCmd = &cobra.Command{
+	Use:   "activities",
+	Short: "List time entry activities",
+	Long:  `List the time entry activities with their IDs and the default activity`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runEnumeration(cmd, "Time entry activities", func(c *client.Client) (enumerationOutput, error) {
+			response, err := c.GetTimeEntryActivities()
+			if err != nil {
+				return enumerationOutput{}, err
+			}
+			out := enumerationOutput{header: []string{"ID", "Default", "Active", "Name"}, data: response.TimeEntryActivities}
+			for _, activity := range response.TimeEntryActivities {
+				out.rows = append(out.rows, []string{
+					strconv.Itoa(activity.ID),
+					formatYesNo(activity.IsDefault),
+					formatYesNo(activity.Active == nil || *activity.Active),
+					activity.Name,
+				})
+			}
+			return out, nil
+		})
+	},
+}
+
+var categoriesCmd = &cobra.Command{
+	Use:   "categories [project]",
+	Short: "List the issue categories of a project",
+	Long:  `List the issue categories of a project (ID or identifier) with their default assignees`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runEnumeration(cmd, "Issue categories of "+args[0], func(c *client.Client) (enumerationOutput, error) {
+			response, err := c.GetIssueCategories(args[0])
+			if err != nil {
+				return enumerationOutput{}, err
+			}
+			out := enumerationOutput{header: []string{"ID", "Assignee", "Name"}, data: response.IssueCategories}
+			for _, category := range response.IssueCategories {
+				assignee := ""
+				if category.AssignedTo != nil {
+					assignee = category.AssignedTo.Name
+				}
+				out.rows = append(out.rows, []string{strconv.Itoa(category.ID), assignee, category.Name})
+			}
+			return out, nil
+		})
+	},
+}
+
+// runEnumeration prints a lookup list fetched by list in the --format of cmd
+func runEnumeration(cmd *cobra.Command, title string, list func(c *client.Client) (enumerationOutput, error)) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	profile, err := cfg.GetCurrentProfile()
+	if err != nil {
+		fmt.Printf("Error getting current profile: %v\n", err)
+		fmt.Println("Please add a profile using 'redmine profile add'")
+		return
+	}
+
+	if profile.APIKey == "" {
+		fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	if profile.RedmineURL == "" {
+		fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	format, _ := cmd.Flags().GetString("format")
+	if err := validateOutputFormat(format); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+	out, err := list(c)
+	if err != nil {
+		fmt.Printf("Error getting %s: %v\n", cmd.Name(), err)
+		return
+	}
+
+	if format == "table" {
+		if len(out.rows) == 0 {
+			fmt.Printf("No %s found.\n", cmd.Name())
+			return
+		}
+		fmt.Printf("%s (Total: %d)\n", title, len(out.rows))
+	}
+	if err := writeOutput(format, out.header, out.rows, out.data); err != nil {
+		fmt.Printf("Error writing output: %v\n", err)
+	}
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{statusesCmd, trackersCmd, prioritiesCmd, activitiesCmd, categoriesCmd} {
+		rootCmd.AddCommand(cmd)
+		cmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	}
+}
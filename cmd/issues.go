@@ -24,6 +24,20 @@ func init() {
 	issuesCmd.AddCommand(addIssueCmd)
 	issuesCmd.AddCommand(editIssueCmd)
 	issuesCmd.AddCommand(urlIssueCmd)
+	issuesCmd.AddCommand(templatesIssueCmd)
+	issuesCmd.AddCommand(importIssuesCmd)
+	issuesCmd.AddCommand(exportIssuesCmd)
+	issuesCmd.AddCommand(graphIssueCmd)
+	issuesCmd.AddCommand(closeIssueCmd)
+	issuesCmd.AddCommand(reopenIssueCmd)
+	issuesCmd.AddCommand(startIssueCmd)
+	issuesCmd.AddCommand(resolveIssueCmd)
+	issuesCmd.AddCommand(commentIssueCmd)
+	issuesCmd.AddCommand(repliesIssueCmd)
+	issuesCmd.AddCommand(historyIssueCmd)
+	issuesCmd.AddCommand(treeIssueCmd)
+	issuesCmd.AddCommand(splitIssueCmd)
+	issuesCmd.AddCommand(reparentIssueCmd)
 
 	// Add flags to list command
 	listIssuesCmd.Flags().String("limit", "25", "Number of issues to retrieve")
@@ -31,24 +45,86 @@ func init() {
 	listIssuesCmd.Flags().String("project", "", "Project ID to filter by")
 	listIssuesCmd.Flags().String("status", "", "Status ID to filter by")
 	listIssuesCmd.Flags().Bool("me", false, "Filter issues authored by current user")
+	listIssuesCmd.Flags().Bool("watched", false, "Filter issues watched by current user")
+	listIssuesCmd.Flags().String("query", "", "Run a saved query (ID or name)")
+
+	// Add flags to status shortcut commands
+	for _, cmd := range []*cobra.Command{closeIssueCmd, reopenIssueCmd, startIssueCmd, resolveIssueCmd} {
+		cmd.Flags().String("notes", "", "Add a comment with the status change")
+	}
+
+	// Add flags to comment command
+	commentIssueCmd.Flags().StringP("message", "m", "", "Comment text")
+	commentIssueCmd.Flags().String("file", "", "Read the comment from a file ('-' for stdin)")
+	commentIssueCmd.Flags().String("reply", "", "Canned reply name from ~/.redminecli/replies")
+	commentIssueCmd.Flags().StringArray("var", nil, "Reply variable (key=value), can be repeated")
+	commentIssueCmd.Flags().Bool("edit", false, "Review the comment in $EDITOR before sending")
+	commentIssueCmd.Flags().Bool("private", false, "Make the comment a private note")
 
 	// Add flags to show command
 	showIssueCmd.Flags().BoolP("comments", "c", false, "Include comments (journals) in the output")
+	showIssueCmd.Flags().Bool("watchers", false, "Include watchers in the output")
+
+	// Add flags to history command
+	historyIssueCmd.Flags().StringArray("field", nil, "Only show changes to this field (e.g. status, assignee, notes), can be repeated")
+	historyIssueCmd.Flags().String("user", "", "Only show changes by this user (name, ID or 'me')")
+	historyIssueCmd.Flags().String("since", "", "Only show changes on or after this date (YYYY-MM-DD, today, yesterday or a weekday)")
+	historyIssueCmd.Flags().String("format", "timeline", "Output format (timeline, json)")
+
+	// Add flags to hierarchy commands
+	treeIssueCmd.Flags().Int("depth", 0, "Number of subtask levels to show (0 for all)")
+	treeIssueCmd.Flags().String("format", "tree", "Output format (tree, json)")
+	splitIssueCmd.Flags().String("file", "", "Read checklist items from a file ('-' for stdin)")
+	splitIssueCmd.Flags().StringArray("item", nil, "Subtask subject, can be repeated")
+	splitIssueCmd.Flags().String("tracker", "", "Tracker name or ID (default: the issue's tracker)")
+	splitIssueCmd.Flags().String("assignee", "", "Assignee email, login, name, ID or 'me'")
+	splitIssueCmd.Flags().Bool("dry-run", false, "Show the subtasks without creating them")
+	reparentIssueCmd.Flags().String("parent", "", "New parent issue ID, or 'none' to remove the parent")
 
 	// Add flags to add command
 	addIssueCmd.Flags().String("project", "", "Project number")
 	addIssueCmd.Flags().String("tracker", "", "Tracker number")
 	addIssueCmd.Flags().String("title", "", "Issue title")
 	addIssueCmd.Flags().String("description", "", "Issue description")
+	addIssueCmd.Flags().String("description-file", "", "Read the description from a file ('-' for stdin)")
 	addIssueCmd.Flags().String("parent", "", "Parent issue ID")
-	addIssueCmd.Flags().String("assignee", "", "Assignee email")
+	addIssueCmd.Flags().String("assignee", "", "Assignee email, login, name, ID or 'me' (groups by name)")
 	addIssueCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
 	addIssueCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD)")
+	addIssueCmd.Flags().StringArray("attach", nil, "File to attach, can be repeated")
+	addIssueCmd.Flags().StringArray("watcher", nil, "Watcher email, login, name, ID or 'me', can be repeated")
+	addIssueCmd.Flags().StringArray("cf", nil, "Custom field value as Name=Value; multi-value fields take a comma-separated list or the flag repeated")
+	addIssueCmd.Flags().String("template", "", "Issue template name")
+	addIssueCmd.Flags().StringArray("var", nil, "Template variable (key=value), can be repeated")
+
+	// Add flags to import command
+	importIssuesCmd.Flags().String("format", "", "Input format (csv, yaml, jsonl); detected from extension by default")
+	importIssuesCmd.Flags().String("mapping", "", "YAML file mapping source columns to issue fields")
+	importIssuesCmd.Flags().Int("concurrency", 4, "Maximum number of issues created in parallel")
+	importIssuesCmd.Flags().String("checkpoint", "", "Checkpoint file (default: <file>.checkpoint.json)")
+	importIssuesCmd.Flags().Bool("dry-run", false, "Validate the file without creating issues")
+
+	// Add flags to export command
+	exportIssuesCmd.Flags().StringArray("filter", nil, "Issue filter as key=value (e.g. project_id=1, status_id=open), can be repeated")
+	exportIssuesCmd.Flags().String("format", "csv", "Output format (csv, md, html, json)")
+	exportIssuesCmd.Flags().StringSlice("include", nil, "Additional data to include (journals, relations, attachments)")
+	exportIssuesCmd.Flags().StringP("output", "o", "", "Output file (default: stdout)")
+
+	// Add flags to graph command
+	graphIssueCmd.Flags().String("format", "dot", "Output format (dot, mermaid)")
+	graphIssueCmd.Flags().Int("depth", 2, "Number of links to follow from the issue")
+	graphIssueCmd.Flags().StringP("output", "o", "", "Output file (default: stdout)")
 
 	// Add flags to edit command
 	editIssueCmd.Flags().String("subject", "", "New issue subject/title")
 	editIssueCmd.Flags().String("description", "", "New issue description")
+	editIssueCmd.Flags().String("description-file", "", "Read the new description from a file ('-' for stdin)")
+	editIssueCmd.Flags().Bool("edit-description", false, "Edit the current description in $EDITOR")
 	editIssueCmd.Flags().String("notes", "", "Add notes/comments to the issue")
+	editIssueCmd.Flags().String("notes-file", "", "Read notes from a file ('-' for stdin)")
 	editIssueCmd.Flags().String("status_id", "", "Status ID")
 	editIssueCmd.Flags().String("assigned_to_id", "", "User ID to assign the issue to")
+	editIssueCmd.Flags().String("assignee", "", "Assignee email, login, name or 'me' (groups by name)")
+	editIssueCmd.Flags().StringArray("cf", nil, "Custom field value as Name=Value; multi-value fields take a comma-separated list or the flag repeated")
+	editIssueCmd.Flags().StringArray("attach", nil, "File to attach, can be repeated")
 }
@@ -50,13 +50,6 @@ var addIssueCmd = &cobra.Command{
 			return
 		}
 
-		// Get users list
-		usersResp, err := c.GetUsers()
-		if err != nil {
-			fmt.Printf("Error getting users: %v\n", err)
-			return
-		}
-
 		// Get trackers list
 		trackersResp, err := c.GetTrackers()
 		if err != nil {
@@ -65,64 +58,94 @@ var addIssueCmd = &cobra.Command{
 		}
 
 		reader := bufio.NewReader(os.Stdin)
+		interactive := inputAllowed()
+
+		// Load and render the issue template if requested
+		var tmpl *config.IssueTemplate
+		templateName, _ := cmd.Flags().GetString("template")
+		if templateName != "" {
+			varFlags, _ := cmd.Flags().GetStringArray("var")
+			tmpl, err = loadIssueTemplate(templateName, varFlags, reader, interactive)
+			if err != nil {
+				fmt.Printf("Error loading template: %v\n", err)
+				return
+			}
+		}
 
 		// Project selection
 		var selectedProject client.Project
 		projectFlag, _ := cmd.Flags().GetString("project")
-		if projectFlag != "" {
+		if projectFlag == "" && tmpl != nil && tmpl.Project != "" {
+			project, ok := findProject(projectsResp.Projects, tmpl.Project)
+			if !ok {
+				fmt.Printf("Project '%s' from template not found\n", tmpl.Project)
+				return
+			}
+			selectedProject = project
+		} else if projectFlag != "" {
 			projectIndex, err := strconv.Atoi(projectFlag)
 			if err != nil || projectIndex < 1 || projectIndex > len(projectsResp.Projects) {
 				fmt.Printf("Invalid project number: %s (available: 1-%d)\n", projectFlag, len(projectsResp.Projects))
 				return
 			}
 			selectedProject = projectsResp.Projects[projectIndex-1]
+		} else if !interactive {
+			exitMissingInput("project", "--project")
 		} else {
-			fmt.Println("Available projects:")
+			names := make([]string, len(projectsResp.Projects))
 			for i, project := range projectsResp.Projects {
-				fmt.Printf("%d. %s\n", i+1, project.Name)
+				names[i] = project.Name
 			}
-			fmt.Print("Select project number: ")
-			projectInput, _ := reader.ReadString('\n')
-			projectInput = strings.TrimSpace(projectInput)
-			projectIndex, err := strconv.Atoi(projectInput)
-			if err != nil || projectIndex < 1 || projectIndex > len(projectsResp.Projects) {
-				fmt.Println("Invalid project selection")
+			projectIndex, err := selectOption(reader, "Project", names)
+			if err != nil {
+				fmt.Printf("Error selecting project: %v\n", err)
 				return
 			}
-			selectedProject = projectsResp.Projects[projectIndex-1]
+			selectedProject = projectsResp.Projects[projectIndex]
 		}
 
 		// Tracker selection
 		var selectedTracker client.Tracker
 		trackerFlag, _ := cmd.Flags().GetString("tracker")
-		if trackerFlag != "" {
+		if trackerFlag == "" && tmpl != nil && tmpl.Tracker != "" {
+			tracker, ok := findTracker(trackersResp.Trackers, tmpl.Tracker)
+			if !ok {
+				fmt.Printf("Tracker '%s' from template not found\n", tmpl.Tracker)
+				return
+			}
+			selectedTracker = tracker
+		} else if trackerFlag != "" {
 			trackerIndex, err := strconv.Atoi(trackerFlag)
 			if err != nil || trackerIndex < 1 || trackerIndex > len(trackersResp.Trackers) {
 				fmt.Printf("Invalid tracker number: %s (available: 1-%d)\n", trackerFlag, len(trackersResp.Trackers))
 				return
 			}
 			selectedTracker = trackersResp.Trackers[trackerIndex-1]
+		} else if !interactive {
+			exitMissingInput("tracker", "--tracker")
 		} else {
-			fmt.Println("Available trackers:")
+			names := make([]string, len(trackersResp.Trackers))
 			for i, tracker := range trackersResp.Trackers {
-				fmt.Printf("%d. %s\n", i+1, tracker.Name)
+				names[i] = tracker.Name
 			}
-			fmt.Print("Select tracker number: ")
-			trackerInput, _ := reader.ReadString('\n')
-			trackerInput = strings.TrimSpace(trackerInput)
-			trackerIndex, err := strconv.Atoi(trackerInput)
-			if err != nil || trackerIndex < 1 || trackerIndex > len(trackersResp.Trackers) {
-				fmt.Println("Invalid tracker selection")
+			trackerIndex, err := selectOption(reader, "Tracker", names)
+			if err != nil {
+				fmt.Printf("Error selecting tracker: %v\n", err)
 				return
 			}
-			selectedTracker = trackersResp.Trackers[trackerIndex-1]
+			selectedTracker = trackersResp.Trackers[trackerIndex]
 		}
 
 		// Title input
 		var title string
 		titleFlag, _ := cmd.Flags().GetString("title")
+		if titleFlag == "" && tmpl != nil {
+			titleFlag = tmpl.Subject
+		}
 		if titleFlag != "" {
 			title = titleFlag
+		} else if !interactive {
+			exitMissingInput("title", "--title")
 		} else {
 			fmt.Print("Enter issue title: ")
 			titleInput, _ := reader.ReadString('\n')
@@ -136,17 +159,33 @@ var addIssueCmd = &cobra.Command{
 		// Description input
 		var description string
 		descriptionFlag, _ := cmd.Flags().GetString("description")
-		if descriptionFlag != "" {
+		descriptionFile, _ := cmd.Flags().GetString("description-file")
+		if descriptionFlag == "" && tmpl != nil {
+			descriptionFlag = tmpl.Description
+		}
+		if descriptionFile != "" {
+			description, err = readTextSource(descriptionFile, reader)
+			if err != nil {
+				fmt.Printf("Error reading description: %v\n", err)
+				return
+			}
+		} else if descriptionFlag != "" {
 			description = descriptionFlag
-		} else {
-			fmt.Print("Enter issue description: ")
-			descriptionInput, _ := reader.ReadString('\n')
-			description = strings.TrimSpace(descriptionInput)
+		} else if interactive {
+			fmt.Println("Opening editor for issue description...")
+			description, err = editText("", "description")
+			if err != nil {
+				fmt.Printf("Error editing description: %v\n", err)
+				return
+			}
 		}
 
 		// Parent issue (optional)
 		var parentIssueID int
 		parentInput, _ := cmd.Flags().GetString("parent")
+		if parentInput == "" && tmpl != nil {
+			parentInput = tmpl.Parent
+		}
 		if parentInput != "" {
 			parentIssueID, err = strconv.Atoi(parentInput)
 			if err != nil {
@@ -157,42 +196,124 @@ var addIssueCmd = &cobra.Command{
 
 		// Assignee selection (optional)
 		var assigneeID int
-		assigneeEmail, _ := cmd.Flags().GetString("assignee")
-		if assigneeEmail != "" {
-			// First check if it's the current user
-			currentUserResp, err := c.GetCurrentUser()
-			if err == nil && currentUserResp.User.Email == assigneeEmail {
-				assigneeID = currentUserResp.User.ID
-			} else {
-				// Search in users list
-				for _, user := range usersResp.Users {
-					if user.Email == assigneeEmail {
-						assigneeID = user.ID
-						break
-					}
+		assignee, _ := cmd.Flags().GetString("assignee")
+		if assignee == "" && tmpl != nil {
+			assignee = tmpl.Assignee
+		}
+		projectRef := strconv.Itoa(selectedProject.ID)
+		userLookup := newUserResolver(c)
+		if assignee == "" && interactive {
+			users, err := assignableUsers(c, projectRef)
+			if err != nil {
+				fmt.Printf("Error getting users: %v\n", err)
+				return
+			}
+			names := []string{"(Not assigned)"}
+			for _, user := range users {
+				if user.Email != "" {
+					names = append(names, fmt.Sprintf("%s <%s>", user.Name, user.Email))
+				} else {
+					names = append(names, user.Name)
 				}
 			}
-			if assigneeID == 0 {
-				fmt.Printf("User with email '%s' not found\n", assigneeEmail)
+			assigneeIndex, err := selectOption(reader, "Assignee", names)
+			if err != nil {
+				fmt.Printf("Error selecting assignee: %v\n", err)
+				return
+			}
+			if assigneeIndex > 0 {
+				assigneeID = users[assigneeIndex-1].ID
+			}
+		} else if assignee != "" {
+			assigneeID, err = userLookup.resolve(projectRef, assignee)
+			if err != nil {
+				fmt.Printf("Error resolving assignee: %v\n", err)
 				return
 			}
 		}
 
+		watchers, _ := cmd.Flags().GetStringArray("watcher")
+		watcherIDs, err := resolveWatchers(userLookup, projectRef, nil, watchers)
+		if err != nil {
+			fmt.Printf("Error resolving watcher: %v\n", err)
+			return
+		}
+
 		// Get dates from flags
 		startDate, _ := cmd.Flags().GetString("start-date")
 		dueDate, _ := cmd.Flags().GetString("due-date")
+		if tmpl != nil {
+			if startDate == "" {
+				startDate = tmpl.StartDate
+			}
+			if dueDate == "" {
+				dueDate = tmpl.DueDate
+			}
+		}
+
+		// Priority (template only)
+		var priorityID int
+		if tmpl != nil {
+			if tmpl.Priority != "" {
+				prioritiesResp, err := c.GetIssuePriorities()
+				if err != nil {
+					fmt.Printf("Error getting priorities: %v\n", err)
+					return
+				}
+				priority, ok := findPriority(prioritiesResp.IssuePriorities, tmpl.Priority)
+				if !ok {
+					fmt.Printf("Priority '%s' from template not found\n", tmpl.Priority)
+					return
+				}
+				priorityID = priority.ID
+			}
+		}
+
+		// Custom fields from the template, overridden by --cf
+		var customFields []client.CustomFieldValue
+		resolver := newCustomFieldResolver(c)
+		resolver.users = userLookup
+		if tmpl != nil && len(tmpl.CustomFields) > 0 {
+			customFields, err = resolver.resolve(projectRef, selectedTracker.ID, nil, templateCustomFieldAssignments(tmpl.CustomFields))
+			if err != nil {
+				fmt.Printf("Error in custom fields: %v\n", err)
+				return
+			}
+		}
+		if cfFlags, _ := cmd.Flags().GetStringArray("cf"); len(cfFlags) > 0 {
+			flagFields, err := resolver.resolve(projectRef, selectedTracker.ID, nil, cfFlags)
+			if err != nil {
+				fmt.Printf("Error in custom fields: %v\n", err)
+				return
+			}
+			for _, field := range flagFields {
+				customFields = mergeCustomFieldValues(customFields, field)
+			}
+		}
+
+		// Upload attachments
+		attachPaths, _ := cmd.Flags().GetStringArray("attach")
+		uploads, err := uploadAttachments(c, attachPaths)
+		if err != nil {
+			fmt.Printf("Error uploading attachment: %v\n", err)
+			return
+		}
 
 		// Create issue request
 		createReq := client.CreateIssueRequest{
 			Issue: client.CreateIssueData{
-				ProjectID:     selectedProject.ID,
-				TrackerID:     selectedTracker.ID,
-				Subject:       title,
-				Description:   description,
-				AssignedToID:  assigneeID,
-				ParentIssueID: parentIssueID,
-				StartDate:     startDate,
-				DueDate:       dueDate,
+				ProjectID:      selectedProject.ID,
+				TrackerID:      selectedTracker.ID,
+				PriorityID:     priorityID,
+				Subject:        title,
+				Description:    description,
+				AssignedToID:   assigneeID,
+				ParentIssueID:  parentIssueID,
+				StartDate:      startDate,
+				DueDate:        dueDate,
+				CustomFields:   customFields,
+				Uploads:        uploads,
+				WatcherUserIDs: watcherIDs,
 			},
 		}
 
@@ -229,3 +350,84 @@ var addIssueCmd = &cobra.Command{
 			assignedTo)
 	},
 }
+
+// loadIssueTemplate loads the named template, prompts for any variables that
+// are still missing after --var flags and defaults, and renders it
+func loadIssueTemplate(name string, varFlags []string, reader *bufio.Reader, interactive bool) (*config.IssueTemplate, error) {
+	tmpl, err := config.LoadIssueTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	for _, v := range varFlags {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --var '%s' (expected key=value)", v)
+		}
+		vars[key] = value
+	}
+	tmpl.ApplyDefaults(vars)
+
+	missing, err := tmpl.MissingVariables(vars)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range missing {
+		if !interactive {
+			return nil, fmt.Errorf("template variable '%s' is required when running without input; specify --var %s=...", v.Name, v.Name)
+		}
+		prompt := v.Prompt
+		if prompt == "" {
+			prompt = v.Name
+		}
+		fmt.Printf("%s: ", prompt)
+		input, _ := reader.ReadString('\n')
+		vars[v.Name] = strings.TrimSpace(input)
+	}
+
+	return tmpl.Render(vars)
+}
+
+// findProject looks up a project by ID, identifier or name (case-insensitive)
+func findProject(projects []client.Project, value string) (client.Project, bool) {
+	for _, project := range projects {
+		if strconv.Itoa(project.ID) == value || project.Identifier == value || strings.EqualFold(project.Name, value) {
+			return project, true
+		}
+	}
+	return client.Project{}, false
+}
+
+// findTracker looks up a tracker by ID or name (case-insensitive)
+func findTracker(trackers []client.Tracker, value string) (client.Tracker, bool) {
+	for _, tracker := range trackers {
+		if strconv.Itoa(tracker.ID) == value || strings.EqualFold(tracker.Name, value) {
+			return tracker, true
+		}
+	}
+	return client.Tracker{}, false
+}
+
+// findPriority looks up a priority by ID or name (case-insensitive)
+func findPriority(priorities []client.Priority, value string) (client.Priority, bool) {
+	for _, priority := range priorities {
+		if strconv.Itoa(priority.ID) == value || strings.EqualFold(priority.Name, value) {
+			return priority, true
+		}
+	}
+	return client.Priority{}, false
+}
+
+// findUser looks up a user by email, login or name (case-insensitive)
+func findUser(users []client.User, value string) (client.User, bool) {
+	for _, user := range users {
+		if strconv.Itoa(user.ID) == value ||
+			strings.EqualFold(user.Email, value) ||
+			strings.EqualFold(user.Login, value) ||
+			strings.EqualFold(user.Name, value) {
+			return user, true
+		}
+	}
+	return client.User{}, false
+}
@@ -0,0 +1,236 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var commentIssueCmd = &cobra.Command{
+	Use:   "comment [issue-id]",
+	Short: "Add a comment to an issue",
+	Long: `Add a comment (note) to an issue.
+
+The comment is given with -m, read from --file ('-' for stdin), or written in
+$EDITOR when neither is given. --private makes it visible only to users
+allowed to see private notes.
+
+--reply uses a canned reply from ~/.redminecli/replies/<name>.md (or .txt).
+Replies are Go templates and can use the issue's {{.id}}, {{.subject}},
+{{.author}}, {{.assignee}}, {{.status}}, {{.tracker}}, {{.project}} and
+{{.url}}, your own name as {{.me}}, and variables given with --var key=value.
+Add --edit to review the reply in $EDITOR before it is sent.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		message, _ := cmd.Flags().GetString("message")
+		file, _ := cmd.Flags().GetString("file")
+		replyName, _ := cmd.Flags().GetString("reply")
+		edit, _ := cmd.Flags().GetBool("edit")
+		private, _ := cmd.Flags().GetBool("private")
+
+		sources := 0
+		for _, set := range []bool{cmd.Flags().Changed("message"), file != "", replyName != ""} {
+			if set {
+				sources++
+			}
+		}
+		if sources > 1 {
+			fmt.Println("Only one of -m, --file and --reply can be used")
+			return
+		}
+		if edit && !inputAllowed() {
+			fmt.Println("--edit needs an interactive terminal")
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		text := message
+		switch {
+		case file != "":
+			text, err = readTextSource(file, os.Stdin)
+			if err != nil {
+				fmt.Printf("Error reading comment: %v\n", err)
+				return
+			}
+		case replyName != "":
+			varFlags, _ := cmd.Flags().GetStringArray("var")
+			text, err = renderReply(c, profile.RedmineURL, issueID, replyName, varFlags)
+			if err != nil {
+				fmt.Printf("Error rendering reply: %v\n", err)
+				return
+			}
+		}
+
+		if edit || sources == 0 {
+			if !inputAllowed() {
+				exitMissingInput("comment", "-m, --file or --reply")
+			}
+			text, err = editText(text, "comment")
+			if err != nil {
+				fmt.Printf("Error editing comment: %v\n", err)
+				return
+			}
+		}
+
+		if strings.TrimSpace(text) == "" {
+			fmt.Println("Comment is empty, nothing was sent.")
+			return
+		}
+
+		req := client.UpdateIssueRequest{
+			Issue: client.UpdateIssueData{
+				Notes:        &text,
+				PrivateNotes: private,
+			},
+		}
+		if _, err := c.UpdateIssue(issueID, req); err != nil {
+			fmt.Printf("Error adding comment: %v\n", err)
+			return
+		}
+
+		if private {
+			fmt.Printf("Added private comment to #%d\n", issueID)
+		} else {
+			fmt.Printf("Added comment to #%d\n", issueID)
+		}
+	},
+}
+
+var repliesIssueCmd = &cobra.Command{
+	Use:   "replies",
+	Short: "List canned replies",
+	Long:  `List the canned replies available to 'issues comment --reply'. Replies are read from ~/.redminecli/replies.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		replies, err := config.ListReplies()
+		if err != nil {
+			fmt.Printf("Error listing replies: %v\n", err)
+			return
+		}
+
+		if len(replies) == 0 {
+			dir, _ := config.GetRepliesDir()
+			fmt.Printf("No replies found. Add text files to: %s\n", dir)
+			return
+		}
+
+		fmt.Printf("Canned replies (Total: %d)\n", len(replies))
+		fmt.Println(strings.Repeat("-", 80))
+
+		for _, reply := range replies {
+			firstLine, _, _ := strings.Cut(strings.TrimSpace(reply.Text), "\n")
+			fmt.Printf("%s | %s | %s\n", reply.Name, truncateString(firstLine, 50), reply.Path)
+
+			variables, err := reply.Variables()
+			if err != nil {
+				fmt.Printf("    Error: %v\n", err)
+				continue
+			}
+			if len(variables) > 0 {
+				fmt.Printf("    Variables: %s\n", strings.Join(variables, ", "))
+			}
+		}
+	},
+}
+
+// renderReply renders a canned reply for an issue. Issue variables are
+// filled in from the issue, --var flags override them, and any variable
+// still missing is prompted for.
+func renderReply(c *client.Client, baseURL string, issueID int, name string, varFlags []string) (string, error) {
+	reply, err := config.LoadReply(name)
+	if err != nil {
+		return "", err
+	}
+
+	variables, err := reply.Variables()
+	if err != nil {
+		return "", err
+	}
+
+	response, err := c.GetIssue(issueID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue %d: %w", issueID, err)
+	}
+	issue := response.Issue
+
+	vars := map[string]string{
+		"id":       strconv.Itoa(issue.ID),
+		"subject":  issue.Subject,
+		"author":   issue.Author.Name,
+		"assignee": assigneeName(issue),
+		"status":   issue.Status.Name,
+		"tracker":  issue.Tracker.Name,
+		"project":  issue.Project.Name,
+		"url":      fmt.Sprintf("%s/issues/%d", strings.TrimSuffix(baseURL, "/"), issue.ID),
+	}
+	if containsString(variables, "me") {
+		user, err := c.GetCurrentUser()
+		if err != nil {
+			return "", fmt.Errorf("failed to get current user: %w", err)
+		}
+		vars["me"] = user.User.Name
+	}
+
+	for _, v := range varFlags {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return "", fmt.Errorf("invalid --var '%s' (expected key=value)", v)
+		}
+		vars[key] = value
+	}
+
+	var reader *bufio.Reader
+	for _, name := range variables {
+		if _, ok := vars[name]; ok {
+			continue
+		}
+		if !inputAllowed() {
+			return "", fmt.Errorf("reply variable '%s' is required when running without input; specify --var %s=...", name, name)
+		}
+		if reader == nil {
+			reader = bufio.NewReader(os.Stdin)
+		}
+		fmt.Printf("%s: ", name)
+		input, _ := reader.ReadString('\n')
+		vars[name] = strings.TrimSpace(input)
+	}
+
+	return reply.Render(vars)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
 	"github.com/UNILORN/redmine-cli/client"
@@ -49,7 +50,7 @@ var editIssueCmd = &cobra.Command{
 		c := client.NewClient(profile.RedmineURL, profile.APIKey)
 
 		// Check if issue exists
-		_, err = c.GetIssue(issueID)
+		current, err := c.GetIssue(issueID)
 		if err != nil {
 			fmt.Printf("Error getting issue %d: %v\n", issueID, err)
 			return
@@ -65,6 +66,40 @@ var editIssueCmd = &cobra.Command{
 		statusIDStr, _ := cmd.Flags().GetString("status_id")
 		assignedToIDStr, _ := cmd.Flags().GetString("assigned_to_id")
 
+		descriptionFile, _ := cmd.Flags().GetString("description-file")
+		editDescription, _ := cmd.Flags().GetBool("edit-description")
+		notesFile, _ := cmd.Flags().GetString("notes-file")
+
+		if descriptionFile == "-" && notesFile == "-" {
+			fmt.Println("Only one of --description-file and --notes-file can read from stdin")
+			return
+		}
+
+		// Read descriptions and notes from files, stdin or the editor
+		if descriptionFile != "" {
+			description, err = readTextSource(descriptionFile, os.Stdin)
+			if err != nil {
+				fmt.Printf("Error reading description: %v\n", err)
+				return
+			}
+		} else if editDescription {
+			description, err = editText(current.Issue.Description, "description")
+			if err != nil {
+				fmt.Printf("Error editing description: %v\n", err)
+				return
+			}
+			if description == current.Issue.Description {
+				description = ""
+			}
+		}
+		if notesFile != "" {
+			notes, err = readTextSource(notesFile, os.Stdin)
+			if err != nil {
+				fmt.Printf("Error reading notes: %v\n", err)
+				return
+			}
+		}
+
 		// Set optional fields if provided
 		if subject != "" {
 			updateData.Subject = &subject
@@ -91,15 +126,57 @@ var editIssueCmd = &cobra.Command{
 			}
 			updateData.AssignedToID = &assignedToID
 		}
+		if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
+			assignedToID, err := resolveAssignee(c, strconv.Itoa(current.Issue.Project.ID), assignee)
+			if err != nil {
+				fmt.Printf("Error resolving assignee: %v\n", err)
+				return
+			}
+			updateData.AssignedToID = &assignedToID
+		}
+
+		if cfFlags, _ := cmd.Flags().GetStringArray("cf"); len(cfFlags) > 0 {
+			issue := current.Issue
+			updateData.CustomFields, err = newCustomFieldResolver(c).resolve(strconv.Itoa(issue.Project.ID), issue.Tracker.ID, issue.CustomFields, cfFlags)
+			if err != nil {
+				fmt.Printf("Error in custom fields: %v\n", err)
+				return
+			}
+		}
+
+		attachPaths, _ := cmd.Flags().GetStringArray("attach")
+
+		// Fall back to writing notes in the editor when running interactively
+		if updateData.Subject == nil && updateData.Description == nil &&
+			updateData.Notes == nil && updateData.StatusID == nil &&
+			updateData.AssignedToID == nil && len(updateData.CustomFields) == 0 &&
+			len(attachPaths) == 0 && !editDescription && inputAllowed() {
+			notes, err = editText("", "notes")
+			if err != nil {
+				fmt.Printf("Error editing notes: %v\n", err)
+				return
+			}
+			if notes != "" {
+				updateData.Notes = &notes
+			}
+		}
 
 		// Check if any update data is provided
 		if updateData.Subject == nil && updateData.Description == nil &&
 			updateData.Notes == nil && updateData.StatusID == nil &&
-			updateData.AssignedToID == nil {
+			updateData.AssignedToID == nil && len(updateData.CustomFields) == 0 &&
+			len(attachPaths) == 0 {
 			fmt.Println("No update data provided. Please specify at least one option to update.")
 			return
 		}
 
+		// Upload attachments
+		updateData.Uploads, err = uploadAttachments(c, attachPaths)
+		if err != nil {
+			fmt.Printf("Error uploading attachment: %v\n", err)
+			return
+		}
+
 		// Update the issue
 		updateReq := client.UpdateIssueRequest{
 			Issue: updateData,
@@ -0,0 +1,443 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// issueExport is the archive written by 'issues export --format json'
+type issueExport struct {
+	ExportedAt time.Time         `json:"exported_at"`
+	RedmineURL string            `json:"redmine_url"`
+	Filters    map[string]string `json:"filters,omitempty"`
+	Include    []string          `json:"include,omitempty"`
+	TotalCount int               `json:"total_count"`
+	Issues     []client.Issue    `json:"issues"`
+}
+
+var exportIssuesCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export issues to CSV, Markdown, HTML or JSON",
+	Long: `Export every issue matching the given filters to a single self-contained file.
+
+Filters are passed to the Redmine issues API as key=value pairs, for example
+--filter project_id=1 --filter status_id=open. Use --include to add journals,
+relations and attachments to the export.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		filterFlags, _ := cmd.Flags().GetStringArray("filter")
+		include, _ := cmd.Flags().GetStringSlice("include")
+
+		switch format {
+		case "csv", "md", "html", "json":
+		default:
+			fmt.Printf("Invalid format: %s (available: csv, md, html, json)\n", format)
+			return
+		}
+
+		for _, inc := range include {
+			switch inc {
+			case "journals", "relations", "attachments":
+			default:
+				fmt.Printf("Invalid include: %s (available: journals, relations, attachments)\n", inc)
+				return
+			}
+		}
+
+		params := make(map[string]string)
+		for _, f := range filterFlags {
+			key, value, ok := strings.Cut(f, "=")
+			if !ok || key == "" {
+				fmt.Printf("Invalid filter '%s' (expected key=value)\n", f)
+				return
+			}
+			params[key] = value
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		issues, err := c.GetAllIssues(params)
+		if err != nil {
+			fmt.Printf("Error getting issues: %v\n", err)
+			return
+		}
+
+		// Journals are only returned by the single issue endpoint
+		if len(include) > 0 {
+			for i, issue := range issues {
+				fmt.Fprintf(os.Stderr, "\rFetching details %d/%d", i+1, len(issues))
+				response, err := c.GetIssue(issue.ID, include...)
+				if err != nil {
+					fmt.Fprintln(os.Stderr)
+					fmt.Printf("Error getting issue #%d: %v\n", issue.ID, err)
+					return
+				}
+				issues[i] = response.Issue
+			}
+			fmt.Fprintln(os.Stderr)
+		}
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				fmt.Printf("Error creating output file: %v\n", err)
+				return
+			}
+			defer file.Close()
+			w = file
+		}
+
+		export := issueExport{
+			ExportedAt: time.Now(),
+			RedmineURL: strings.TrimSuffix(profile.RedmineURL, "/"),
+			Filters:    params,
+			Include:    include,
+			TotalCount: len(issues),
+			Issues:     issues,
+		}
+
+		switch format {
+		case "csv":
+			err = writeIssuesCSV(w, export)
+		case "md":
+			err = writeIssuesMarkdown(w, export)
+		case "html":
+			err = writeIssuesHTML(w, export)
+		case "json":
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			err = encoder.Encode(export)
+		}
+		if err != nil {
+			fmt.Printf("Error writing export: %v\n", err)
+			return
+		}
+
+		if output != "" {
+			fmt.Printf("Exported %d issues to %s\n", len(issues), output)
+		}
+	},
+}
+
+func (e issueExport) includes(name string) bool {
+	for _, inc := range e.Include {
+		if inc == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (e issueExport) issueURL(id int) string {
+	return fmt.Sprintf("%s/issues/%d", e.RedmineURL, id)
+}
+
+// formatRelation describes a relation from the point of view of issueID
+func formatRelation(issueID int, relation client.Relation) string {
+	if relation.IssueID == issueID {
+		text := fmt.Sprintf("%s #%d", relation.RelationType, relation.IssueToID)
+		if relation.Delay != nil && *relation.Delay != 0 {
+			text += fmt.Sprintf(" (%d days)", *relation.Delay)
+		}
+		return text
+	}
+
+	reverse := map[string]string{
+		"blocks":     "blocked",
+		"precedes":   "follows",
+		"duplicates": "duplicated",
+		"copied_to":  "copied_from",
+	}
+	relationType := relation.RelationType
+	if r, ok := reverse[relationType]; ok {
+		relationType = r
+	}
+	return fmt.Sprintf("%s #%d", relationType, relation.IssueID)
+}
+
+func formatHours(hours *float64) string {
+	if hours == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*hours, 'f', -1, 64)
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func assigneeName(issue client.Issue) string {
+	if issue.AssignedTo == nil {
+		return ""
+	}
+	return issue.AssignedTo.Name
+}
+
+func writeIssuesCSV(w io.Writer, export issueExport) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"ID", "Project", "Tracker", "Status", "Priority", "Subject", "Author", "Assignee",
+		"StartDate", "DueDate", "DoneRatio", "EstimatedHours", "SpentHours", "Created", "Updated", "URL", "Description"}
+	if export.includes("journals") {
+		header = append(header, "Journals")
+	}
+	if export.includes("relations") {
+		header = append(header, "Relations")
+	}
+	if export.includes("attachments") {
+		header = append(header, "Attachments")
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, issue := range export.Issues {
+		record := []string{
+			strconv.Itoa(issue.ID),
+			issue.Project.Name,
+			issue.Tracker.Name,
+			issue.Status.Name,
+			issue.Priority.Name,
+			issue.Subject,
+			issue.Author.Name,
+			assigneeName(issue),
+			stringOrEmpty(issue.StartDate),
+			stringOrEmpty(issue.DueDate),
+			strconv.Itoa(issue.DoneRatio),
+			formatHours(issue.EstimatedHours),
+			formatHours(issue.SpentHours),
+			issue.CreatedOn.Format(time.RFC3339),
+			issue.UpdatedOn.Format(time.RFC3339),
+			export.issueURL(issue.ID),
+			issue.Description,
+		}
+
+		if export.includes("journals") {
+			var notes []string
+			for _, journal := range issue.Journals {
+				if journal.Notes == "" {
+					continue
+				}
+				notes = append(notes, fmt.Sprintf("[%s] %s: %s",
+					journal.CreatedOn.Format("2006-01-02 15:04"), journal.User.Name, journal.Notes))
+			}
+			record = append(record, strings.Join(notes, "\n---\n"))
+		}
+		if export.includes("relations") {
+			var relations []string
+			for _, relation := range issue.Relations {
+				relations = append(relations, formatRelation(issue.ID, relation))
+			}
+			record = append(record, strings.Join(relations, "; "))
+		}
+		if export.includes("attachments") {
+			var attachments []string
+			for _, attachment := range issue.Attachments {
+				attachments = append(attachments, attachment.Filename)
+			}
+			record = append(record, strings.Join(attachments, "; "))
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeMarkdownCell keeps table cells on one line and escapes pipes
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(s, "\r", "")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func writeIssuesMarkdown(w io.Writer, export issueExport) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Issues export\n\n")
+	fmt.Fprintf(&b, "Exported %s from %s (%d issues)\n\n", export.ExportedAt.Format("2006-01-02 15:04"), export.RedmineURL, export.TotalCount)
+
+	fmt.Fprintln(&b, "| ID | Tracker | Status | Priority | Subject | Assignee | Due | Done | Updated |")
+	fmt.Fprintln(&b, "|---:|---|---|---|---|---|---|---:|---|")
+	for _, issue := range export.Issues {
+		fmt.Fprintf(&b, "| [#%d](%s) | %s | %s | %s | %s | %s | %s | %d%% | %s |\n",
+			issue.ID, export.issueURL(issue.ID),
+			escapeMarkdownCell(issue.Tracker.Name),
+			escapeMarkdownCell(issue.Status.Name),
+			escapeMarkdownCell(issue.Priority.Name),
+			escapeMarkdownCell(issue.Subject),
+			escapeMarkdownCell(assigneeName(issue)),
+			stringOrEmpty(issue.DueDate),
+			issue.DoneRatio,
+			issue.UpdatedOn.Format("2006-01-02"))
+	}
+
+	if len(export.Include) > 0 {
+		for _, issue := range export.Issues {
+			fmt.Fprintf(&b, "\n## [#%d](%s) %s\n", issue.ID, export.issueURL(issue.ID), issue.Subject)
+
+			if export.includes("relations") && len(issue.Relations) > 0 {
+				fmt.Fprint(&b, "\n### Relations\n\n")
+				for _, relation := range issue.Relations {
+					fmt.Fprintf(&b, "- %s\n", formatRelation(issue.ID, relation))
+				}
+			}
+
+			if export.includes("attachments") && len(issue.Attachments) > 0 {
+				fmt.Fprint(&b, "\n### Attachments\n\n")
+				for _, attachment := range issue.Attachments {
+					fmt.Fprintf(&b, "- [%s](%s) (%d bytes)\n", attachment.Filename, attachment.ContentURL, attachment.Filesize)
+				}
+			}
+
+			if export.includes("journals") && len(issue.Journals) > 0 {
+				fmt.Fprintln(&b, "\n### Journals")
+				for _, journal := range issue.Journals {
+					fmt.Fprintf(&b, "\n**%s** - %s\n", journal.User.Name, journal.CreatedOn.Format("2006-01-02 15:04"))
+					for _, detail := range journal.Details {
+						fmt.Fprintf(&b, "- %s: %s -> %s\n", detail.Name, detail.OldValue, detail.NewValue)
+					}
+					if journal.Notes != "" {
+						fmt.Fprintf(&b, "\n%s\n", journal.Notes)
+					}
+				}
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var issuesHTMLTemplate = template.Must(template.New("export").Funcs(template.FuncMap{
+	"url":      func(e issueExport, id int) string { return e.issueURL(id) },
+	"assignee": assigneeName,
+	"str":      stringOrEmpty,
+	"relation": formatRelation,
+	"date":     func(t time.Time) string { return t.Format("2006-01-02") },
+	"datetime": func(t time.Time) string { return t.Format("2006-01-02 15:04") },
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Issues export</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; cursor: pointer; user-select: none; }
+th.asc::after { content: " \25B2"; }
+th.desc::after { content: " \25BC"; }
+tr:nth-child(even) { background: #fafafa; }
+details { margin: 0.5em 0; }
+pre { white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>Issues export</h1>
+<p>Exported {{datetime .ExportedAt}} from <a href="{{.RedmineURL}}">{{.RedmineURL}}</a> ({{.TotalCount}} issues)</p>
+<table id="issues">
+<thead>
+<tr><th data-type="number">ID</th><th>Project</th><th>Tracker</th><th>Status</th><th>Priority</th><th>Subject</th><th>Assignee</th><th>Due</th><th data-type="number">Done</th><th>Updated</th></tr>
+</thead>
+<tbody>
+{{- $e := . }}
+{{- range .Issues}}
+<tr><td data-value="{{.ID}}"><a href="{{url $e .ID}}">#{{.ID}}</a></td><td>{{.Project.Name}}</td><td>{{.Tracker.Name}}</td><td>{{.Status.Name}}</td><td>{{.Priority.Name}}</td><td>{{.Subject}}</td><td>{{assignee .}}</td><td>{{str .DueDate}}</td><td data-value="{{.DoneRatio}}">{{.DoneRatio}}%</td><td>{{date .UpdatedOn}}</td></tr>
+{{- end}}
+</tbody>
+</table>
+{{- if .Include}}
+<h2>Details</h2>
+{{- range .Issues}}
+{{- $issue := .}}
+<details>
+<summary><a href="{{url $e .ID}}">#{{.ID}}</a> {{.Subject}}</summary>
+{{- if .Relations}}
+<h4>Relations</h4>
+<ul>{{range .Relations}}<li>{{relation $issue.ID .}}</li>{{end}}</ul>
+{{- end}}
+{{- if .Attachments}}
+<h4>Attachments</h4>
+<ul>{{range .Attachments}}<li><a href="{{.ContentURL}}">{{.Filename}}</a> ({{.Filesize}} bytes)</li>{{end}}</ul>
+{{- end}}
+{{- if .Journals}}
+<h4>Journals</h4>
+{{- range .Journals}}
+<p><strong>{{.User.Name}}</strong> - {{datetime .CreatedOn}}</p>
+{{- if .Details}}<ul>{{range .Details}}<li>{{.Name}}: {{.OldValue}} -&gt; {{.NewValue}}</li>{{end}}</ul>{{end}}
+{{- if .Notes}}<pre>{{.Notes}}</pre>{{end}}
+{{- end}}
+{{- end}}
+</details>
+{{- end}}
+{{- end}}
+<script>
+document.querySelectorAll("#issues th").forEach(function (th, index) {
+  th.addEventListener("click", function () {
+    var tbody = document.querySelector("#issues tbody");
+    var rows = Array.prototype.slice.call(tbody.rows);
+    var asc = !th.classList.contains("asc");
+    var numeric = th.dataset.type === "number";
+    document.querySelectorAll("#issues th").forEach(function (h) { h.classList.remove("asc", "desc"); });
+    th.classList.add(asc ? "asc" : "desc");
+    rows.sort(function (a, b) {
+      var x = a.cells[index].dataset.value || a.cells[index].textContent;
+      var y = b.cells[index].dataset.value || b.cells[index].textContent;
+      var cmp = numeric ? parseFloat(x) - parseFloat(y) : x.localeCompare(y);
+      return asc ? cmp : -cmp;
+    });
+    rows.forEach(function (row) { tbody.appendChild(row); });
+  });
+});
+</script>
+</body>
+</html>
+`))
+
+func writeIssuesHTML(w io.Writer, export issueExport) error {
+	return issuesHTMLTemplate.Execute(w, export)
+}
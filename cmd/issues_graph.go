@@ -0,0 +1,341 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// issueGraph is the network of issues written by 'issues graph'
+type issueGraph struct {
+	Nodes map[int]client.Issue
+	Edges []issueGraphEdge
+}
+
+// issueGraphEdge is a relation, or a "subtask" edge from a parent to a child
+type issueGraphEdge struct {
+	From  int
+	To    int
+	Type  string
+	Delay *int
+}
+
+var graphIssueCmd = &cobra.Command{
+	Use:   "graph [issue-id | key=value...]",
+	Short: "Export the relation network of issues as a graph",
+	Long: `Export issue relations and parent/child links as a Graphviz (dot) or
+Mermaid graph.
+
+Given an issue ID, the graph follows relations, parents and subtasks from that
+issue up to --depth steps. Given filters such as project_id=1 status_id=open,
+the graph contains the matching issues and the issues they are linked to.
+
+Examples:
+  redmine issues graph 123 | dot -Tsvg -o issue-123.svg
+  redmine issues graph project_id=my-project fixed_version_id=4 --format mermaid`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "dot" && format != "mermaid" {
+			fmt.Printf("Invalid format: %s (available: dot, mermaid)\n", format)
+			return
+		}
+		depth, _ := cmd.Flags().GetInt("depth")
+		output, _ := cmd.Flags().GetString("output")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		var graph *issueGraph
+		if issueID, err := parseIssueRef(args[0]); err == nil && len(args) == 1 {
+			graph, err = walkIssueGraph(c, issueID, depth)
+			if err != nil {
+				fmt.Printf("Error building graph: %v\n", err)
+				return
+			}
+		} else {
+			params := map[string]string{"include": "relations"}
+			for _, arg := range args {
+				key, value, ok := strings.Cut(arg, "=")
+				if !ok || key == "" {
+					fmt.Printf("Invalid filter '%s' (expected an issue ID or key=value)\n", arg)
+					return
+				}
+				params[key] = value
+			}
+
+			issues, err := c.GetAllIssues(params)
+			if err != nil {
+				fmt.Printf("Error getting issues: %v\n", err)
+				return
+			}
+			if len(issues) == 0 {
+				fmt.Println("No issues found.")
+				return
+			}
+			graph = newIssueGraph()
+			for _, issue := range issues {
+				graph.add(issue)
+			}
+		}
+
+		// Issues only reached through an edge are fetched for their labels
+		if err := graph.loadMissingNodes(c); err != nil {
+			fmt.Printf("Error getting issues: %v\n", err)
+			return
+		}
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				fmt.Printf("Error creating output file: %v\n", err)
+				return
+			}
+			defer file.Close()
+			w = file
+		}
+
+		if format == "mermaid" {
+			err = graph.writeMermaid(w)
+		} else {
+			err = graph.writeDot(w)
+		}
+		if err != nil {
+			fmt.Printf("Error writing graph: %v\n", err)
+			return
+		}
+
+		if output != "" {
+			fmt.Printf("Wrote graph of %d issues and %d links to %s\n", len(graph.Nodes), len(graph.Edges), output)
+		}
+	},
+}
+
+func newIssueGraph() *issueGraph {
+	return &issueGraph{Nodes: make(map[int]client.Issue)}
+}
+
+// walkIssueGraph collects the issues reachable from issueID through
+// relations, parents and subtasks, fetching up to depth steps away. Issues
+// that cannot be seen are kept as bare IDs without following their links.
+func walkIssueGraph(c *client.Client, issueID, depth int) (*issueGraph, error) {
+	graph := newIssueGraph()
+	queue := []int{issueID}
+	for level := 0; len(queue) > 0 && level <= depth; level++ {
+		var next []int
+		for _, id := range queue {
+			if _, ok := graph.Nodes[id]; ok {
+				continue
+			}
+			response, err := c.GetIssue(id, "relations", "children")
+			if err != nil {
+				// Related issues in other projects may not be visible; they
+				// stay in the graph as bare IDs like in loadMissingNodes
+				if id != issueID && (client.IsForbidden(err) || client.IsNotFound(err)) {
+					graph.Nodes[id] = client.Issue{ID: id}
+					continue
+				}
+				return nil, fmt.Errorf("failed to get issue #%d: %w", id, err)
+			}
+			issue := response.Issue
+			graph.add(issue)
+
+			for _, relation := range issue.Relations {
+				next = append(next, relatedIssueID(issue.ID, relation))
+			}
+			if issue.Parent != nil {
+				next = append(next, issue.Parent.ID)
+			}
+			for _, child := range issue.Children {
+				next = append(next, child.ID)
+			}
+		}
+		queue = next
+	}
+	return graph, nil
+}
+
+// add adds an issue with its relations and parent link. Relations seen from
+// both issues are added once.
+func (g *issueGraph) add(issue client.Issue) {
+	g.Nodes[issue.ID] = issue
+
+	for _, relation := range issue.Relations {
+		if g.hasEdge(relation.IssueID, relation.IssueToID, relation.RelationType) {
+			continue
+		}
+		g.Edges = append(g.Edges, issueGraphEdge{
+			From:  relation.IssueID,
+			To:    relation.IssueToID,
+			Type:  relation.RelationType,
+			Delay: relation.Delay,
+		})
+	}
+	if issue.Parent != nil && !g.hasEdge(issue.Parent.ID, issue.ID, "subtask") {
+		g.Edges = append(g.Edges, issueGraphEdge{From: issue.Parent.ID, To: issue.ID, Type: "subtask"})
+	}
+	for _, child := range issue.Children {
+		if !g.hasEdge(issue.ID, child.ID, "subtask") {
+			g.Edges = append(g.Edges, issueGraphEdge{From: issue.ID, To: child.ID, Type: "subtask"})
+		}
+	}
+}
+
+func (g *issueGraph) hasEdge(from, to int, edgeType string) bool {
+	for _, edge := range g.Edges {
+		if edge.From == from && edge.To == to && edge.Type == edgeType {
+			return true
+		}
+	}
+	return false
+}
+
+// loadMissingNodes fetches the issues at the ends of edges that are not in
+// the graph yet. Issues that cannot be seen are left as bare IDs.
+func (g *issueGraph) loadMissingNodes(c *client.Client) error {
+	var missing []int
+	for _, edge := range g.Edges {
+		for _, id := range []int{edge.From, edge.To} {
+			if _, ok := g.Nodes[id]; !ok {
+				missing = append(missing, id)
+			}
+		}
+	}
+
+	issues, err := getIssuesByID(c, missing)
+	if err != nil {
+		return err
+	}
+	for _, id := range missing {
+		issue, ok := issues[id]
+		if !ok {
+			issue = client.Issue{ID: id}
+		}
+		g.Nodes[id] = issue
+	}
+	return nil
+}
+
+func (g *issueGraph) sortedIDs() []int {
+	ids := make([]int, 0, len(g.Nodes))
+	for id := range g.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (e issueGraphEdge) label() string {
+	if e.Delay != nil && *e.Delay != 0 {
+		return fmt.Sprintf("%s (%dd)", e.Type, *e.Delay)
+	}
+	return e.Type
+}
+
+func issueGraphLabel(issue client.Issue) string {
+	if issue.Subject == "" {
+		return fmt.Sprintf("#%d", issue.ID)
+	}
+	return fmt.Sprintf("#%d %s\n%s", issue.ID, truncateString(issue.Subject, 40), issue.Status.Name)
+}
+
+// writeDot writes the graph in Graphviz format. Closed issues are greyed
+// out, relates links have no direction and subtask links are dotted.
+func (g *issueGraph) writeDot(w io.Writer) error {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+	}
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "digraph issues {")
+	fmt.Fprintln(&b, "  rankdir=LR;")
+	fmt.Fprintln(&b, "  node [shape=box];")
+	for _, id := range g.sortedIDs() {
+		issue := g.Nodes[id]
+		attrs := "label=" + quote(issueGraphLabel(issue))
+		if issue.Status.IsClosed || issue.ClosedOn != nil {
+			attrs += ", style=filled, fillcolor=lightgrey"
+		}
+		fmt.Fprintf(&b, "  %d [%s];\n", id, attrs)
+	}
+	for _, edge := range g.Edges {
+		attrs := "label=" + quote(edge.label())
+		switch edge.Type {
+		case "relates":
+			attrs += ", dir=none"
+		case "subtask":
+			attrs += ", style=dotted"
+		}
+		fmt.Fprintf(&b, "  %d -> %d [%s];\n", edge.From, edge.To, attrs)
+	}
+	fmt.Fprintln(&b, "}")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeMermaid writes the graph as a Mermaid flowchart
+func (g *issueGraph) writeMermaid(w io.Writer) error {
+	quote := func(s string) string {
+		return `"` + strings.NewReplacer(`"`, "#quot;", "\n", "<br>").Replace(s) + `"`
+	}
+
+	var b strings.Builder
+	fmt.Fprintln(&b, "flowchart LR")
+	var closed []string
+	for _, id := range g.sortedIDs() {
+		issue := g.Nodes[id]
+		fmt.Fprintf(&b, "  i%d[%s]\n", id, quote(issueGraphLabel(issue)))
+		if issue.Status.IsClosed || issue.ClosedOn != nil {
+			closed = append(closed, "i"+strconv.Itoa(id))
+		}
+	}
+	for _, edge := range g.Edges {
+		arrow := "-->"
+		switch edge.Type {
+		case "relates":
+			arrow = "---"
+		case "subtask":
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  i%d %s|%s| i%d\n", edge.From, arrow, quote(edge.label()), edge.To)
+	}
+	if len(closed) > 0 {
+		fmt.Fprintln(&b, "  classDef closed fill:#eee,stroke:#999,color:#666")
+		fmt.Fprintf(&b, "  class %s closed\n", strings.Join(closed, ","))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
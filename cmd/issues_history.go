@@ -0,0 +1,440 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// historyAttributes are the display names of issue attributes in journals
+var historyAttributes = map[string]string{
+	"project_id":       "Project",
+	"tracker_id":       "Tracker",
+	"status_id":        "Status",
+	"priority_id":      "Priority",
+	"assigned_to_id":   "Assignee",
+	"author_id":        "Author",
+	"category_id":      "Category",
+	"fixed_version_id": "Target version",
+	"parent_id":        "Parent task",
+	"subject":          "Subject",
+	"description":      "Description",
+	"start_date":       "Start date",
+	"due_date":         "Due date",
+	"done_ratio":       "% Done",
+	"estimated_hours":  "Estimated time",
+	"is_private":       "Private",
+}
+
+var historyIssueCmd = &cobra.Command{
+	Use:   "history [issue-id]",
+	Short: "Show the change history of an issue",
+	Long: `Show the changes and comments of an issue as a timeline.
+
+IDs are shown as names, and changes to long text such as the description are
+shown as word diffs with removed words as [-...-] and added words as {+...+}.
+Values of user, version and key/value list custom fields are shown as names
+when the field definitions can be read, which requires admin rights.
+
+--field limits the history to fields given by name, e.g. status, assignee,
+description or a custom field name ("notes" selects comments). --user limits
+it to changes by a user (name, ID or "me"), and --since to changes on or after
+a date (YYYY-MM-DD, today, yesterday or a weekday).`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "timeline" && format != "json" {
+			fmt.Printf("Invalid format: %s (available: timeline, json)\n", format)
+			return
+		}
+		fields, _ := cmd.Flags().GetStringArray("field")
+		user, _ := cmd.Flags().GetString("user")
+		since, _ := cmd.Flags().GetString("since")
+		if since != "" {
+			since, err = parseDateArg(since, time.Now())
+			if err != nil {
+				fmt.Printf("Invalid --since: %v\n", err)
+				return
+			}
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetIssue(issueID, "journals")
+		if err != nil {
+			fmt.Printf("Error getting issue: %v\n", err)
+			return
+		}
+		issue := response.Issue
+
+		if user == "me" {
+			currentUser, err := c.GetCurrentUser()
+			if err != nil {
+				fmt.Printf("Error getting current user: %v\n", err)
+				return
+			}
+			user = strconv.Itoa(currentUser.User.ID)
+		}
+
+		resolver := newHistoryResolver(c, issue)
+		showNotes := len(fields) == 0 || containsFold(fields, "notes") || containsFold(fields, "comment")
+
+		entries := []historyEntry{}
+		for _, journal := range issue.Journals {
+			if since != "" && journal.CreatedOn.Local().Format("2006-01-02") < since {
+				continue
+			}
+			if user != "" && strconv.Itoa(journal.User.ID) != user && !strings.EqualFold(journal.User.Name, user) {
+				continue
+			}
+
+			entry := historyEntry{
+				Journal:   journal.ID,
+				User:      journal.User.Name,
+				CreatedOn: journal.CreatedOn,
+				Private:   journal.PrivateNotes,
+			}
+			if showNotes {
+				entry.Notes = journal.Notes
+			}
+			for _, detail := range journal.Details {
+				change := resolver.change(detail)
+				if len(fields) == 0 || historyFieldMatches(change, fields) {
+					entry.Changes = append(entry.Changes, change)
+				}
+			}
+
+			if entry.Notes == "" && len(entry.Changes) == 0 {
+				continue
+			}
+			entries = append(entries, entry)
+		}
+
+		if format == "json" {
+			if err := writeOutput("json", nil, nil, entries); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		}
+
+		fmt.Printf("History of #%d: %s (%d entries)\n", issue.ID, issue.Subject, len(entries))
+		for _, entry := range entries {
+			private := ""
+			if entry.Private {
+				private = " (private)"
+			}
+			fmt.Printf("\n%s  %s  [journal %d]%s\n", entry.CreatedOn.Local().Format("2006-01-02 15:04"), entry.User, entry.Journal, private)
+			for _, change := range entry.Changes {
+				if change.Diff != "" {
+					fmt.Printf("  %s: %s\n", change.Field, indentLines(change.Diff, "    "))
+					continue
+				}
+				fmt.Printf("  %s: %s -> %s\n", change.Field, historyValue(change.Old), historyValue(change.New))
+			}
+			if entry.Notes != "" {
+				fmt.Printf("  Notes: %s\n", indentLines(strings.TrimRight(entry.Notes, "\n"), "    "))
+			}
+		}
+	},
+}
+
+// historyEntry is a journal with its changes resolved for display
+type historyEntry struct {
+	Journal   int             `json:"journal"`
+	User      string          `json:"user"`
+	CreatedOn time.Time       `json:"created_on"`
+	Private   bool            `json:"private,omitempty"`
+	Notes     string          `json:"notes,omitempty"`
+	Changes   []historyChange `json:"changes,omitempty"`
+}
+
+// historyChange is a journal detail with IDs resolved to names. Diff holds a
+// word diff for long text values.
+type historyChange struct {
+	Field    string `json:"field"`
+	Property string `json:"property"`
+	Name     string `json:"name"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+	Diff     string `json:"diff,omitempty"`
+}
+
+// historyResolver turns the IDs in journal details into names. Lookup
+// lists are loaded on first use; IDs that cannot be resolved are kept.
+type historyResolver struct {
+	c       *client.Client
+	issue   client.Issue
+	project string
+	names   map[string]map[string]string
+	fields  map[string]client.CustomFieldDefinition
+}
+
+func newHistoryResolver(c *client.Client, issue client.Issue) *historyResolver {
+	r := &historyResolver{
+		c:       c,
+		issue:   issue,
+		project: strconv.Itoa(issue.Project.ID),
+		names:   make(map[string]map[string]string),
+	}
+
+	// Users already known from the issue save looking up the members
+	users := make(map[string]string)
+	users[strconv.Itoa(issue.Author.ID)] = issue.Author.Name
+	if issue.AssignedTo != nil {
+		users[strconv.Itoa(issue.AssignedTo.ID)] = issue.AssignedTo.Name
+	}
+	for _, journal := range issue.Journals {
+		users[strconv.Itoa(journal.User.ID)] = journal.User.Name
+	}
+	r.names["known_users"] = users
+
+	return r
+}
+
+// change resolves a journal detail
+func (r *historyResolver) change(detail client.JournalDetail) historyChange {
+	change := historyChange{
+		Field:    detail.Name,
+		Property: detail.Property,
+		Name:     detail.Name,
+		Old:      detail.OldValue,
+		New:      detail.NewValue,
+	}
+
+	switch detail.Property {
+	case "attr":
+		if name, ok := historyAttributes[detail.Name]; ok {
+			change.Field = name
+		}
+		change.Old = r.value(detail.Name, detail.OldValue)
+		change.New = r.value(detail.Name, detail.NewValue)
+	case "cf":
+		field := r.customField(detail.Name)
+		change.Field = "Custom field " + detail.Name
+		if field.Name != "" {
+			change.Field = field.Name
+		}
+		for _, cf := range r.issue.CustomFields {
+			if strconv.Itoa(cf.ID) == detail.Name {
+				change.Field = cf.Name
+			}
+		}
+		change.Old = r.customValue(field, detail.OldValue)
+		change.New = r.customValue(field, detail.NewValue)
+	case "attachment":
+		change.Field = "File"
+	case "relation":
+		change.Field = "Relation " + detail.Name
+		change.Old = issueRefValue(detail.OldValue)
+		change.New = issueRefValue(detail.NewValue)
+	}
+
+	if isLongText(change.Old) || isLongText(change.New) || (change.Name == "description" && change.Property == "attr") {
+		change.Diff = wordDiff(change.Old, change.New)
+	}
+	return change
+}
+
+// value resolves the value of an issue attribute
+func (r *historyResolver) value(attr, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	switch attr {
+	case "parent_id":
+		return issueRefValue(value)
+	case "is_private":
+		return formatYesNo(value == "1" || value == "true")
+	case "assigned_to_id", "author_id":
+		if name, ok := r.names["known_users"][value]; ok {
+			return name
+		}
+		attr = "users"
+	case "status_id", "tracker_id", "priority_id", "category_id", "fixed_version_id", "project_id":
+	default:
+		return value
+	}
+
+	names, ok := r.names[attr]
+	if !ok {
+		names = r.load(attr)
+		r.names[attr] = names
+	}
+	if name, ok := names[value]; ok {
+		return name
+	}
+	return value
+}
+
+// customField returns the definition of a custom field of the project. The
+// format is only known with admin rights; errors leave the field unknown.
+func (r *historyResolver) customField(id string) client.CustomFieldDefinition {
+	if r.fields == nil {
+		r.fields = make(map[string]client.CustomFieldDefinition)
+		if fields, err := newCustomFieldResolver(r.c).definitions(r.project); err == nil {
+			for _, field := range fields {
+				r.fields[strconv.Itoa(field.ID)] = field
+			}
+		}
+	}
+	return r.fields[id]
+}
+
+// customValue resolves the value of a custom field by its format: users,
+// versions and key/value list entries are stored as IDs
+func (r *historyResolver) customValue(field client.CustomFieldDefinition, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	switch field.FieldFormat {
+	case "user":
+		return r.value("assigned_to_id", value)
+	case "version":
+		return r.value("fixed_version_id", value)
+	case "bool":
+		return formatYesNo(value == "1")
+	case "enumeration":
+		for _, choice := range field.PossibleValues {
+			if choice.Value == value && choice.Label != "" {
+				return choice.Label
+			}
+		}
+	}
+	return value
+}
+
+// load fetches the names for an attribute. Errors leave the IDs unresolved.
+func (r *historyResolver) load(attr string) map[string]string {
+	names := make(map[string]string)
+	add := func(id int, name string) {
+		names[strconv.Itoa(id)] = name
+	}
+
+	switch attr {
+	case "status_id":
+		if response, err := r.c.GetIssueStatuses(); err == nil {
+			for _, status := range response.IssueStatuses {
+				add(status.ID, status.Name)
+			}
+		}
+	case "tracker_id":
+		if response, err := r.c.GetTrackers(); err == nil {
+			for _, tracker := range response.Trackers {
+				add(tracker.ID, tracker.Name)
+			}
+		}
+	case "priority_id":
+		if response, err := r.c.GetIssuePriorities(); err == nil {
+			for _, priority := range response.IssuePriorities {
+				add(priority.ID, priority.Name)
+			}
+		}
+	case "category_id":
+		if response, err := r.c.GetIssueCategories(r.project); err == nil {
+			for _, category := range response.IssueCategories {
+				add(category.ID, category.Name)
+			}
+		}
+	case "fixed_version_id":
+		if response, err := r.c.GetVersions(r.project); err == nil {
+			for _, version := range response.Versions {
+				add(version.ID, version.Name)
+			}
+		}
+	case "project_id":
+		if projects, err := r.c.GetAllProjects(nil); err == nil {
+			for _, project := range projects {
+				add(project.ID, project.Name)
+			}
+		}
+	case "users":
+		if users, err := assignableUsers(r.c, r.project); err == nil {
+			for _, user := range users {
+				add(user.ID, user.Name)
+			}
+		}
+	}
+	return names
+}
+
+// historyFieldMatches reports whether a change is one of the fields asked
+// for, by display name, raw name or raw name without the _id suffix
+func historyFieldMatches(change historyChange, fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(field, change.Field) || strings.EqualFold(field, change.Name) ||
+			strings.EqualFold(field+"_id", change.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLongText reports whether a value is better shown as a diff
+func isLongText(value string) bool {
+	return strings.Contains(value, "\n") || len(value) > 80
+}
+
+func issueRefValue(value string) string {
+	if value == "" {
+		return ""
+	}
+	return "#" + value
+}
+
+func historyValue(value string) string {
+	if value == "" {
+		return "(empty)"
+	}
+	return value
+}
+
+// indentLines indents every line after the first
+func indentLines(text, indent string) string {
+	return strings.ReplaceAll(text, "\n", "\n"+indent)
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,613 @@
+package cmd
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// importMapping describes how source columns map onto issue fields
+type importMapping struct {
+	Columns      map[string]string `yaml:"columns"`
+	CustomFields map[string]string `yaml:"custom_fields"`
+	Defaults     map[string]string `yaml:"defaults"`
+}
+
+// importRow is a single record read from the source file
+type importRow struct {
+	Line   int
+	Fields map[string]string
+}
+
+// importItem is a validated row ready to be created
+type importItem struct {
+	Line      int
+	Key       string
+	ParentKey string
+	Depth     int
+	Data      client.CreateIssueData
+}
+
+// importCheckpoint records which rows have already been created so an
+// interrupted import can be resumed
+type importCheckpoint struct {
+	Source  string         `json:"source"`
+	Created map[string]int `json:"created"`
+
+	path string
+	mu   sync.Mutex
+}
+
+var importIssuesCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import issues from CSV, YAML or JSONL",
+	Long: `Create issues in bulk from a CSV, YAML or JSONL file.
+
+Columns are mapped to issue fields (key, parent, project, tracker, priority, assignee,
+subject, description, start_date, due_date) either by name or through a mapping file
+given with --mapping. Projects, trackers, priorities and assignees are resolved by name.
+The parent column may reference the key of another row in the same file or an existing
+issue ID. All rows are validated before anything is created, and created issues are
+recorded in a checkpoint file so that an interrupted import can be resumed. Rows without
+a key are identified by their content, and a checkpoint can only be used with the file
+it was created for.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		sourcePath := args[0]
+		format, _ := cmd.Flags().GetString("format")
+		mappingPath, _ := cmd.Flags().GetString("mapping")
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		checkpointPath, _ := cmd.Flags().GetString("checkpoint")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if concurrency < 1 {
+			concurrency = 1
+		}
+		if checkpointPath == "" {
+			checkpointPath = sourcePath + ".checkpoint.json"
+		}
+
+		rows, err := readImportRows(sourcePath, format)
+		if err != nil {
+			fmt.Printf("Error reading %s: %v\n", sourcePath, err)
+			return
+		}
+		if len(rows) == 0 {
+			fmt.Println("No rows to import.")
+			return
+		}
+
+		mapping := &importMapping{}
+		if mappingPath != "" {
+			mapping, err = loadImportMapping(mappingPath)
+			if err != nil {
+				fmt.Printf("Error loading mapping: %v\n", err)
+				return
+			}
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		items, errs := validateImportRows(c, rows, mapping)
+		if len(errs) > 0 {
+			fmt.Printf("Validation failed with %d error(s):\n", len(errs))
+			for _, e := range errs {
+				fmt.Printf("  - %s\n", e)
+			}
+			return
+		}
+
+		checkpoint, err := loadImportCheckpoint(checkpointPath, sourcePath)
+		if err != nil {
+			fmt.Printf("Error loading checkpoint: %v\n", err)
+			return
+		}
+
+		pending := 0
+		for _, item := range items {
+			if _, done := checkpoint.Created[item.Key]; !done {
+				pending++
+			}
+		}
+
+		fmt.Printf("Validated %d row(s): %d to create, %d already imported\n", len(items), pending, len(items)-pending)
+		if dryRun || pending == 0 {
+			return
+		}
+
+		created, failed := runImport(c, items, checkpoint, concurrency)
+
+		fmt.Printf("\nImport finished: %d created, %d failed\n", created, len(failed))
+		if len(failed) > 0 {
+			for _, e := range failed {
+				fmt.Printf("  - %s\n", e)
+			}
+			fmt.Printf("Re-run the same command to retry; progress is saved in %s\n", checkpointPath)
+		}
+	},
+}
+
+// readImportRows reads records from a CSV, YAML or JSONL file
+func readImportRows(path, format string) ([]importRow, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".yaml", ".yml":
+			format = "yaml"
+		case ".jsonl", ".ndjson":
+			format = "jsonl"
+		default:
+			return nil, fmt.Errorf("cannot detect format from extension, use --format csv|yaml|jsonl")
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var rows []importRow
+	switch format {
+	case "csv":
+		reader := csv.NewReader(file)
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+		if len(records) == 0 {
+			return nil, nil
+		}
+		header := records[0]
+		for i, record := range records[1:] {
+			fields := make(map[string]string, len(header))
+			for j, name := range header {
+				if j < len(record) {
+					fields[strings.TrimSpace(name)] = record[j]
+				}
+			}
+			rows = append(rows, importRow{Line: i + 2, Fields: fields})
+		}
+	case "yaml":
+		var records []map[string]interface{}
+		if err := yaml.NewDecoder(file).Decode(&records); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML: %w", err)
+		}
+		for i, record := range records {
+			rows = append(rows, importRow{Line: i + 1, Fields: stringifyFields(record)})
+		}
+	case "jsonl":
+		scanner := bufio.NewScanner(file)
+		scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+		line := 0
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			decoder := json.NewDecoder(strings.NewReader(text))
+			decoder.UseNumber()
+			var record map[string]interface{}
+			if err := decoder.Decode(&record); err != nil {
+				return nil, fmt.Errorf("line %d: failed to parse JSON: %w", line, err)
+			}
+			rows = append(rows, importRow{Line: line, Fields: stringifyFields(record)})
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	return rows, nil
+}
+
+func stringifyFields(record map[string]interface{}) map[string]string {
+	fields := make(map[string]string, len(record))
+	for key, value := range record {
+		if value == nil {
+			continue
+		}
+		fields[key] = fmt.Sprint(value)
+	}
+	return fields
+}
+
+func loadImportMapping(path string) (*importMapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var mapping importMapping
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping: %w", err)
+	}
+	return &mapping, nil
+}
+
+// value returns the row's value for an issue field, honoring the column
+// mapping and falling back to the mapping defaults
+func (m *importMapping) value(row importRow, field string) string {
+	column := field
+	if mapped, ok := m.Columns[field]; ok {
+		column = mapped
+	}
+	if value := strings.TrimSpace(row.Fields[column]); value != "" {
+		return value
+	}
+	return m.Defaults[field]
+}
+
+// validateImportRows resolves every row against Redmine and returns the items
+// to create, or all validation errors found
+func validateImportRows(c *client.Client, rows []importRow, mapping *importMapping) ([]*importItem, []string) {
+	var errs []string
+
+	projectsResp, err := c.GetProjects()
+	if err != nil {
+		return nil, []string{fmt.Sprintf("failed to get projects: %v", err)}
+	}
+	trackersResp, err := c.GetTrackers()
+	if err != nil {
+		return nil, []string{fmt.Sprintf("failed to get trackers: %v", err)}
+	}
+
+	// Priorities are only fetched when a row needs them; users are looked
+	// up through one resolver shared with the custom fields
+	var priorities []client.Priority
+	customFields := newCustomFieldResolver(c)
+	users := customFields.users
+
+	items := make([]*importItem, 0, len(rows))
+	byKey := make(map[string]*importItem)
+	contentKeys := make(map[string]int)
+
+	for _, row := range rows {
+		item := &importItem{Line: row.Line}
+		fail := func(format string, a ...interface{}) {
+			errs = append(errs, fmt.Sprintf("row %d: %s", row.Line, fmt.Sprintf(format, a...)))
+		}
+
+		item.Key = mapping.value(row, "key")
+		if item.Key == "" {
+			// Rows without a key are identified by their content, so that
+			// adding or removing lines keeps the checkpoint valid
+			item.Key = importContentKey(row)
+			contentKeys[item.Key]++
+			if n := contentKeys[item.Key]; n > 1 {
+				item.Key = fmt.Sprintf("%s-%d", item.Key, n)
+			}
+		}
+		if _, exists := byKey[item.Key]; exists {
+			fail("duplicate key '%s'", item.Key)
+			continue
+		}
+		byKey[item.Key] = item
+
+		item.Data.Subject = mapping.value(row, "subject")
+		if item.Data.Subject == "" {
+			fail("subject is required")
+		}
+
+		// Descriptions are kept verbatim
+		descColumn := "description"
+		if mapped, ok := mapping.Columns["description"]; ok {
+			descColumn = mapped
+		}
+		item.Data.Description = row.Fields[descColumn]
+		if item.Data.Description == "" {
+			item.Data.Description = mapping.Defaults["description"]
+		}
+
+		if value := mapping.value(row, "project"); value == "" {
+			fail("project is required")
+		} else if project, ok := findProject(projectsResp.Projects, value); !ok {
+			fail("project '%s' not found", value)
+		} else {
+			item.Data.ProjectID = project.ID
+		}
+
+		if value := mapping.value(row, "tracker"); value != "" {
+			if tracker, ok := findTracker(trackersResp.Trackers, value); !ok {
+				fail("tracker '%s' not found", value)
+			} else {
+				item.Data.TrackerID = tracker.ID
+			}
+		}
+
+		if value := mapping.value(row, "priority"); value != "" {
+			if priorities == nil {
+				prioritiesResp, err := c.GetIssuePriorities()
+				if err != nil {
+					fail("failed to get priorities: %v", err)
+					continue
+				}
+				priorities = prioritiesResp.IssuePriorities
+			}
+			if priority, ok := findPriority(priorities, value); !ok {
+				fail("priority '%s' not found", value)
+			} else {
+				item.Data.PriorityID = priority.ID
+			}
+		}
+
+		if value := mapping.value(row, "assignee"); value != "" && item.Data.ProjectID != 0 {
+			if id, err := users.resolve(strconv.Itoa(item.Data.ProjectID), value); err != nil {
+				fail("assignee: %v", err)
+			} else {
+				item.Data.AssignedToID = id
+			}
+		}
+
+		item.Data.StartDate = mapping.value(row, "start_date")
+		item.Data.DueDate = mapping.value(row, "due_date")
+
+		// Custom fields are keyed by name or ID and resolved per project
+		for name, column := range mapping.CustomFields {
+			value := strings.TrimSpace(row.Fields[column])
+			if value == "" || item.Data.ProjectID == 0 {
+				continue
+			}
+			field, err := customFields.value(strconv.Itoa(item.Data.ProjectID), item.Data.TrackerID, nil, name, value)
+			if err != nil {
+				fail("%v", err)
+				continue
+			}
+			item.Data.CustomFields = append(item.Data.CustomFields, field)
+		}
+
+		item.ParentKey = mapping.value(row, "parent")
+		items = append(items, item)
+	}
+
+	errs = append(errs, resolveImportParents(items, byKey)...)
+
+	return items, errs
+}
+
+// resolveImportParents links each item to its parent and computes its depth
+// so parents are always created before their children. A local key takes
+// precedence over an issue ID.
+func resolveImportParents(items []*importItem, byKey map[string]*importItem) []string {
+	var errs []string
+
+	for _, item := range items {
+		if item.ParentKey == "" {
+			continue
+		}
+		if _, ok := byKey[item.ParentKey]; ok {
+			continue
+		}
+		id, err := strconv.Atoi(strings.TrimPrefix(item.ParentKey, "#"))
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("row %d: parent '%s' is neither a key in this file nor an issue ID", item.Line, item.ParentKey))
+			item.ParentKey = ""
+			continue
+		}
+		item.Data.ParentIssueID = id
+		item.ParentKey = ""
+	}
+
+	for _, item := range items {
+		depth := 0
+		seen := map[string]bool{item.Key: true}
+		for parent := item.ParentKey; parent != ""; {
+			if seen[parent] {
+				errs = append(errs, fmt.Sprintf("row %d: parent cycle detected through '%s'", item.Line, parent))
+				break
+			}
+			seen[parent] = true
+			depth++
+			next, ok := byKey[parent]
+			if !ok {
+				break
+			}
+			parent = next.ParentKey
+		}
+		item.Depth = depth
+	}
+
+	return errs
+}
+
+// runImport creates the items level by level with at most concurrency
+// requests in flight, recording each created issue in the checkpoint
+func runImport(c *client.Client, items []*importItem, checkpoint *importCheckpoint, concurrency int) (int, []string) {
+	levels := make(map[int][]*importItem)
+	maxDepth := 0
+	for _, item := range items {
+		levels[item.Depth] = append(levels[item.Depth], item)
+		if item.Depth > maxDepth {
+			maxDepth = item.Depth
+		}
+	}
+
+	var mu sync.Mutex
+	created := 0
+	var failed []string
+	failedKeys := make(map[string]bool)
+
+	for depth := 0; depth <= maxDepth; depth++ {
+		jobs := make(chan *importItem)
+		var wg sync.WaitGroup
+
+		for i := 0; i < concurrency; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for item := range jobs {
+					data := item.Data
+					if item.ParentKey != "" {
+						mu.Lock()
+						parentFailed := failedKeys[item.ParentKey]
+						mu.Unlock()
+						parentID, ok := checkpoint.get(item.ParentKey)
+						if parentFailed || !ok {
+							mu.Lock()
+							failedKeys[item.Key] = true
+							failed = append(failed, fmt.Sprintf("row %d: skipped because parent '%s' was not created", item.Line, item.ParentKey))
+							mu.Unlock()
+							continue
+						}
+						data.ParentIssueID = parentID
+					}
+
+					response, err := c.CreateIssue(client.CreateIssueRequest{Issue: data})
+					if err != nil {
+						mu.Lock()
+						failedKeys[item.Key] = true
+						failed = append(failed, fmt.Sprintf("row %d: %v", item.Line, err))
+						mu.Unlock()
+						continue
+					}
+
+					if err := checkpoint.record(item.Key, response.Issue.ID); err != nil {
+						fmt.Printf("Warning: failed to write checkpoint: %v\n", err)
+					}
+
+					mu.Lock()
+					created++
+					mu.Unlock()
+					fmt.Printf("Created #%d from row %d: %s\n", response.Issue.ID, item.Line, response.Issue.Subject)
+				}
+			}()
+		}
+
+		for _, item := range levels[depth] {
+			if _, done := checkpoint.get(item.Key); done {
+				continue
+			}
+			jobs <- item
+		}
+		close(jobs)
+		wg.Wait()
+	}
+
+	sort.Strings(failed)
+	return created, failed
+}
+
+// importContentKey derives the key of a row without a key column from a
+// hash of its fields
+func importContentKey(row importRow) string {
+	names := make([]string, 0, len(row.Fields))
+	for name := range row.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(hash, "%s\x00%s\x00", name, row.Fields[name])
+	}
+	return "row-" + hex.EncodeToString(hash.Sum(nil))[:12]
+}
+
+func loadImportCheckpoint(path, source string) (*importCheckpoint, error) {
+	if abs, err := filepath.Abs(source); err == nil {
+		source = abs
+	}
+	checkpoint := &importCheckpoint{
+		Source:  source,
+		Created: make(map[string]int),
+		path:    path,
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint %s: %w", path, err)
+	}
+	if !sameImportSource(checkpoint.Source, source) {
+		return nil, fmt.Errorf("checkpoint %s belongs to %s, not %s; use another --checkpoint or remove it", path, checkpoint.Source, source)
+	}
+	if checkpoint.Created == nil {
+		checkpoint.Created = make(map[string]int)
+	}
+
+	return checkpoint, nil
+}
+
+// sameImportSource reports whether two paths name the same source file
+func sameImportSource(a, b string) bool {
+	if filepath.Clean(a) == filepath.Clean(b) {
+		return true
+	}
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	return errA == nil && errB == nil && absA == absB
+}
+
+func (cp *importCheckpoint) get(key string) (int, bool) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	id, ok := cp.Created[key]
+	return id, ok
+}
+
+// record stores a created issue and rewrites the checkpoint file atomically
+func (cp *importCheckpoint) record(key string, issueID int) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+
+	cp.Created[key] = issueID
+
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := cp.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, cp.path)
+}
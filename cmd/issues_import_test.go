@@ -0,0 +1,45 @@
+package cmd
+
+import "testing"
+
+func TestResolveImportParentsUnknownParent(t *testing.T) {
+	items := []*importItem{
+		{Line: 2, Key: "a"},
+		{Line: 3, Key: "b", ParentKey: "foo"},
+		{Line: 4, Key: "c", ParentKey: "b"},
+	}
+	byKey := make(map[string]*importItem)
+	for _, item := range items {
+		byKey[item.Key] = item
+	}
+
+	errs := resolveImportParents(items, byKey)
+
+	if len(errs) != 1 {
+		t.Fatalf("got %d errors, want 1: %v", len(errs), errs)
+	}
+	if want := "row 3: parent 'foo' is neither a key in this file nor an issue ID"; errs[0] != want {
+		t.Errorf("got error %q, want %q", errs[0], want)
+	}
+	if items[2].Depth != 1 {
+		t.Errorf("child of the invalid row has depth %d, want 1", items[2].Depth)
+	}
+}
+
+func TestResolveImportParentsIssueID(t *testing.T) {
+	items := []*importItem{
+		{Line: 2, Key: "a", ParentKey: "#42"},
+		{Line: 3, Key: "b", ParentKey: "a"},
+	}
+	byKey := map[string]*importItem{"a": items[0], "b": items[1]}
+
+	if errs := resolveImportParents(items, byKey); len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if items[0].Data.ParentIssueID != 42 || items[0].ParentKey != "" {
+		t.Errorf("parent issue ID not resolved: %+v", items[0])
+	}
+	if items[0].Depth != 0 || items[1].Depth != 1 {
+		t.Errorf("got depths %d and %d, want 0 and 1", items[0].Depth, items[1].Depth)
+	}
+}
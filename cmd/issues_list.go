@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/UNILORN/redmine-cli/client"
@@ -13,7 +14,11 @@ import (
 var listIssuesCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List issues",
-	Long:  `List all issues from Redmine`,
+	Long: `List issues from Redmine.
+
+--query runs a saved query (ID or name) from the Redmine web UI. The query's
+filters are used, and a project query is run in its project. --status, --me and
+--watched cannot be combined with --query.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := config.Load()
 		if err != nil {
@@ -38,6 +43,17 @@ var listIssuesCmd = &cobra.Command{
 			return
 		}
 
+		// Redmine ignores other filters when query_id is given
+		queryName, _ := cmd.Flags().GetString("query")
+		if queryName != "" {
+			for _, name := range []string{"status", "me", "watched"} {
+				if cmd.Flags().Changed(name) {
+					fmt.Printf("--%s cannot be combined with --query; the saved query's filters are used\n", name)
+					return
+				}
+			}
+		}
+
 		c := client.NewClient(profile.RedmineURL, profile.APIKey)
 
 		params := make(map[string]string)
@@ -75,6 +91,25 @@ var listIssuesCmd = &cobra.Command{
 			params["assigned_to_id"] = fmt.Sprintf("%d", userResp.User.ID)
 		}
 
+		watched, _ := cmd.Flags().GetBool("watched")
+		if watched {
+			params["watcher_id"] = "me"
+		}
+
+		// A saved query brings its own filters; its project is used unless
+		// --project is given
+		if queryName != "" {
+			query, err := findQuery(c, projectID, queryName)
+			if err != nil {
+				fmt.Printf("Error finding query: %v\n", err)
+				return
+			}
+			params["query_id"] = strconv.Itoa(query.ID)
+			if projectID == "" && query.ProjectID != nil {
+				params["project_id"] = strconv.Itoa(*query.ProjectID)
+			}
+		}
+
 		response, err := c.GetIssues(params)
 		if err != nil {
 			fmt.Printf("Error getting issues: %v\n", err)
@@ -48,16 +48,18 @@ var showIssueCmd = &cobra.Command{
 
 		c := client.NewClient(profile.RedmineURL, profile.APIKey)
 
-		// Check if comments flag is set
+		// Check if comments and watchers flags are set
 		includeComments, _ := cmd.Flags().GetBool("comments")
-		var response *client.IssueResponse
-
+		includeWatchers, _ := cmd.Flags().GetBool("watchers")
+		var include []string
 		if includeComments {
-			response, err = c.GetIssue(issueID, "journals")
-		} else {
-			response, err = c.GetIssue(issueID)
+			include = append(include, "journals")
+		}
+		if includeWatchers {
+			include = append(include, "watchers")
 		}
 
+		response, err := c.GetIssue(issueID, include...)
 		if err != nil {
 			fmt.Printf("Error getting issue: %v\n", err)
 			return
@@ -80,6 +82,10 @@ var showIssueCmd = &cobra.Command{
 			fmt.Println("Assigned to: Not assigned")
 		}
 
+		if issue.FixedVersion != nil {
+			fmt.Printf("Target version: %s\n", issue.FixedVersion.Name)
+		}
+
 		if issue.StartDate != nil {
 			fmt.Printf("Start date: %s\n", *issue.StartDate)
 		}
@@ -102,22 +108,37 @@ var showIssueCmd = &cobra.Command{
 			fmt.Println("\nCustom Fields:")
 			fmt.Println(strings.Repeat("-", 20))
 			for _, field := range issue.CustomFields {
-				if field.Value != "" {
-					fmt.Printf("%s: %s\n", field.Name, field.Value)
+				if value := field.Value.String(); value != "" {
+					fmt.Printf("%s: %s\n", field.Name, value)
 				}
 			}
 		}
 
+		if includeWatchers {
+			fmt.Printf("\nWatchers (%d):\n", len(issue.Watchers))
+			fmt.Println(strings.Repeat("-", 20))
+			for _, watcher := range issue.Watchers {
+				fmt.Println(watcher.Name)
+			}
+		}
+
 		// Display comments if requested
 		if includeComments && len(issue.Journals) > 0 {
 			fmt.Println("\nComments:")
 			fmt.Println(strings.Repeat("=", 50))
 
+			// Journal IDs are printed as "journal <id>" so they can be
+			// passed to 'journals edit' and are not mistaken for issues
 			for _, journal := range issue.Journals {
-				fmt.Printf("\n[#%d] %s - %s\n",
+				private := ""
+				if journal.PrivateNotes {
+					private = " (private)"
+				}
+				fmt.Printf("\n[journal %d] %s - %s%s\n",
 					journal.ID,
 					journal.User.Name,
-					journal.CreatedOn.Format("2006-01-02 15:04:05"))
+					journal.CreatedOn.Format("2006-01-02 15:04:05"),
+					private)
 
 				// Show field changes
 				if len(journal.Details) > 0 {
@@ -145,6 +166,8 @@ var showIssueCmd = &cobra.Command{
 
 				fmt.Println(strings.Repeat("-", 30))
 			}
+
+			fmt.Printf("\nEdit a comment with 'redmine journals edit <journal> --issue %d'\n", issue.ID)
 		}
 	},
 }
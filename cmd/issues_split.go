@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// checklistItemPattern matches a list item with an optional checkbox, e.g.
+// "- [ ] Write tests", "* [x] Done", "1. Deploy", "# Textile item" or
+// "Plain line"
+var checklistItemPattern = regexp.MustCompile(`^(?:[-*+]\s+|\d+[.)]\s+|#+\s+)?(\[([ xX]?)\]\s*)?(.*)$`)
+
+// markdownHeadingPattern matches a Markdown heading such as "## Plan"
+var markdownHeadingPattern = regexp.MustCompile(`^#{1,6}\s`)
+
+var splitIssueCmd = &cobra.Command{
+	Use:   "split [issue-id]",
+	Short: "Create subtasks of an issue from a checklist",
+	Long: `Create subtasks of an issue, one per checklist item.
+
+Items are read from --file ('-' for stdin), where every non-empty line is an
+item, or given with --item. Without either, the unchecked checklist items
+("- [ ] ...") of the issue's description are used, and Markdown headings in
+it are reported as skipped. List markers (including Textile "#") and
+checkboxes are removed, and checked items ("- [x] ...") are skipped.
+
+Subtasks are created in the issue's project with its tracker, unless
+--tracker is given.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		file, _ := cmd.Flags().GetString("file")
+		items, _ := cmd.Flags().GetStringArray("item")
+		trackerFlag, _ := cmd.Flags().GetString("tracker")
+		assigneeFlag, _ := cmd.Flags().GetString("assignee")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetIssue(issueID)
+		if err != nil {
+			fmt.Printf("Error getting issue: %v\n", err)
+			return
+		}
+		parent := response.Issue
+
+		var subjects []string
+		switch {
+		case file != "":
+			text, err := readTextSource(file, os.Stdin)
+			if err != nil {
+				fmt.Printf("Error reading checklist: %v\n", err)
+				return
+			}
+			subjects, _ = parseChecklist(text, false)
+		case len(items) == 0:
+			var skipped []string
+			subjects, skipped = parseChecklist(parent.Description, true)
+			for _, line := range skipped {
+				fmt.Printf("Skipped heading: %s\n", line)
+			}
+			if len(subjects) == 0 {
+				fmt.Printf("No unchecked checklist items found in the description of #%d; give them with --file or --item\n", issueID)
+				return
+			}
+		}
+		for _, item := range items {
+			if item = strings.TrimSpace(item); item != "" {
+				subjects = append(subjects, item)
+			}
+		}
+		if len(subjects) == 0 {
+			fmt.Println("No subtasks to create.")
+			return
+		}
+
+		data := client.CreateIssueData{
+			ProjectID:     parent.Project.ID,
+			TrackerID:     parent.Tracker.ID,
+			ParentIssueID: parent.ID,
+		}
+		if trackerFlag != "" {
+			trackers, err := c.GetTrackers()
+			if err != nil {
+				fmt.Printf("Error getting trackers: %v\n", err)
+				return
+			}
+			tracker, ok := findTracker(trackers.Trackers, trackerFlag)
+			if !ok {
+				fmt.Printf("Tracker '%s' not found\n", trackerFlag)
+				return
+			}
+			data.TrackerID = tracker.ID
+		}
+		if assigneeFlag != "" {
+			data.AssignedToID, err = resolveAssignee(c, strconv.Itoa(parent.Project.ID), assigneeFlag)
+			if err != nil {
+				fmt.Printf("Error resolving assignee: %v\n", err)
+				return
+			}
+		}
+
+		if dryRun {
+			fmt.Printf("Would create %d subtasks of #%d %s:\n", len(subjects), parent.ID, parent.Subject)
+			for _, subject := range subjects {
+				fmt.Printf("  %s\n", subject)
+			}
+			return
+		}
+
+		created := 0
+		for _, subject := range subjects {
+			data.Subject = subject
+			response, err := c.CreateIssue(client.CreateIssueRequest{Issue: data})
+			if err != nil {
+				fmt.Printf("Error creating subtask '%s': %v\n", subject, err)
+				continue
+			}
+			created++
+			fmt.Printf("Created #%d %s\n", response.Issue.ID, response.Issue.Subject)
+		}
+
+		fmt.Printf("Created %d of %d subtasks under #%d\n", created, len(subjects), parent.ID)
+	},
+}
+
+// parseChecklist returns the unchecked items of a checklist. With
+// checkboxOnly only lines with a checkbox are items, and Markdown headings
+// are returned as skipped; otherwise every non-empty line is an item, so
+// Textile numbered items ("# Deploy") and subjects such as "#123 follow-up"
+// are kept.
+func parseChecklist(text string, checkboxOnly bool) (items, skipped []string) {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if checkboxOnly && markdownHeadingPattern.MatchString(line) {
+			skipped = append(skipped, line)
+			continue
+		}
+
+		match := checklistItemPattern.FindStringSubmatch(line)
+		hasCheckbox := match[1] != ""
+		if checkboxOnly && !hasCheckbox {
+			continue
+		}
+		if hasCheckbox && strings.TrimSpace(match[2]) != "" {
+			continue
+		}
+		if item := strings.TrimSpace(match[3]); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items, skipped
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// statusShortcuts are the status shortcut commands, in the order they are
+// listed by 'profile show'
+var statusShortcuts = []string{"close", "reopen", "start", "resolve"}
+
+// statusShortcutNames is the status name each shortcut looks for when the
+// profile does not set one
+var statusShortcutNames = map[string]string{
+	"close":   "Closed",
+	"reopen":  "New",
+	"start":   "In Progress",
+	"resolve": "Resolved",
+}
+
+var closeIssueCmd = &cobra.Command{
+	Use:   "close [issue-id...]",
+	Short: "Close issues",
+	Long: `Set issues to the closed status.
+
+The status is the profile's close_status setting ('redmine profile set
+close_status <status>'), or else the closed status named Closed or the first
+closed status.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueStatus(cmd, "close", args)
+	},
+}
+
+var reopenIssueCmd = &cobra.Command{
+	Use:   "reopen [issue-id...]",
+	Short: "Reopen issues",
+	Long: `Set closed issues back to an open status. Issues that are already open
+are left alone.
+
+The status is the profile's reopen_status setting, or else the open status
+named New or the first open status.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueStatus(cmd, "reopen", args)
+	},
+}
+
+var startIssueCmd = &cobra.Command{
+	Use:   "start [issue-id...]",
+	Short: "Start working on issues",
+	Long: `Set issues to the in progress status.
+
+The status is the profile's start_status setting, or else the open status
+named In Progress.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueStatus(cmd, "start", args)
+	},
+}
+
+var resolveIssueCmd = &cobra.Command{
+	Use:   "resolve [issue-id...]",
+	Short: "Resolve issues",
+	Long: `Set issues to the resolved status.
+
+The status is the profile's resolve_status setting, or else the status named
+Resolved.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runIssueStatus(cmd, "resolve", args)
+	},
+}
+
+// runIssueStatus moves the issues in args to the status of a shortcut,
+// checking each issue's workflow first
+func runIssueStatus(cmd *cobra.Command, action string, args []string) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	profile, err := cfg.GetCurrentProfile()
+	if err != nil {
+		fmt.Printf("Error getting current profile: %v\n", err)
+		fmt.Println("Please add a profile using 'redmine profile add'")
+		return
+	}
+
+	if profile.APIKey == "" {
+		fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	if profile.RedmineURL == "" {
+		fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	issueIDs := make([]int, 0, len(args))
+	for _, arg := range args {
+		id, err := parseIssueRef(arg)
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", arg)
+			return
+		}
+		issueIDs = append(issueIDs, id)
+	}
+
+	var notes *string
+	if cmd.Flags().Changed("notes") {
+		value, _ := cmd.Flags().GetString("notes")
+		notes = &value
+	}
+
+	c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+	statuses, err := c.GetIssueStatuses()
+	if err != nil {
+		fmt.Printf("Error getting statuses: %v\n", err)
+		return
+	}
+
+	status, err := statusForShortcut(statuses.IssueStatuses, action, profile.StatusShortcuts[action])
+	if err != nil {
+		fmt.Printf("Error finding status: %v\n", err)
+		return
+	}
+
+	for _, issueID := range issueIDs {
+		response, err := c.GetIssue(issueID, "allowed_statuses")
+		if err != nil {
+			fmt.Printf("Error getting issue %d: %v\n", issueID, err)
+			continue
+		}
+		issue := response.Issue
+
+		if issue.Status.ID == status.ID {
+			fmt.Printf("#%d is already %s\n", issueID, status.Name)
+			continue
+		}
+		// Reopening an open issue would move it back, e.g. from In Progress
+		// to New. is_closed is only returned by Redmine 5.0 and later, so the
+		// status list decides as well.
+		if action == "reopen" && !statusClosed(statuses.IssueStatuses, issue.Status) {
+			fmt.Printf("#%d is already open (%s)\n", issueID, issue.Status.Name)
+			continue
+		}
+
+		// Redmine before 5.0 does not return allowed_statuses; the update
+		// is then checked by the server alone
+		if issue.AllowedStatuses != nil && !statusAllowed(issue.AllowedStatuses, status.ID) {
+			var allowed []string
+			for _, s := range issue.AllowedStatuses {
+				if s.ID != issue.Status.ID {
+					allowed = append(allowed, s.Name)
+				}
+			}
+			fmt.Printf("#%d cannot be changed from %s to %s: the workflow does not allow it for your role and the %s tracker (allowed: %s)\n",
+				issueID, issue.Status.Name, status.Name, issue.Tracker.Name, joinOrNone(allowed))
+			continue
+		}
+
+		req := client.UpdateIssueRequest{
+			Issue: client.UpdateIssueData{
+				StatusID: &status.ID,
+				Notes:    notes,
+			},
+		}
+		if _, err := c.UpdateIssue(issueID, req); err != nil {
+			fmt.Printf("Error updating issue %d: %v\n", issueID, err)
+			continue
+		}
+
+		fmt.Printf("#%d: %s -> %s | %s\n", issueID, issue.Status.Name, status.Name, issue.Subject)
+	}
+}
+
+// statusClosed reports whether status is a closed status
+func statusClosed(statuses []client.Status, status client.Status) bool {
+	if status.IsClosed {
+		return true
+	}
+	for _, s := range statuses {
+		if s.ID == status.ID {
+			return s.IsClosed
+		}
+	}
+	return false
+}
+
+// statusForShortcut finds the status of a shortcut: the configured status
+// (name or ID), or else the status with the shortcut's default name. close
+// and reopen fall back to the first closed or open status.
+func statusForShortcut(statuses []client.Status, action, configured string) (client.Status, error) {
+	if configured != "" {
+		for _, status := range statuses {
+			if strconv.Itoa(status.ID) == configured || strings.EqualFold(status.Name, configured) {
+				return status, nil
+			}
+		}
+		return client.Status{}, fmt.Errorf("status '%s' set by %s_status not found (available: %s)", configured, action, statusNames(statuses))
+	}
+
+	// Closing needs a closed status; the other shortcuts an open one,
+	// except resolve which is either depending on the setup
+	fits := func(status client.Status) bool {
+		switch action {
+		case "close":
+			return status.IsClosed
+		case "reopen", "start":
+			return !status.IsClosed
+		}
+		return true
+	}
+
+	for _, status := range statuses {
+		if fits(status) && strings.EqualFold(status.Name, statusShortcutNames[action]) {
+			return status, nil
+		}
+	}
+	if action == "close" || action == "reopen" {
+		for _, status := range statuses {
+			if fits(status) {
+				return status, nil
+			}
+		}
+	}
+	return client.Status{}, fmt.Errorf("no '%s' status found; set one with 'redmine profile set %s_status <status>' (available: %s)", statusShortcutNames[action], action, statusNames(statuses))
+}
+
+func statusAllowed(statuses []client.Status, id int) bool {
+	for _, status := range statuses {
+		if status.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func statusNames(statuses []client.Status) string {
+	names := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		names = append(names, status.Name)
+	}
+	return joinOrNone(names)
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var templatesIssueCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List issue templates",
+	Long:  `List issue templates available to 'issues add --template'. Templates are read from a repo-local .redminecli/templates directory and from ~/.redminecli/templates.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		templates, err := config.ListIssueTemplates()
+		if err != nil {
+			fmt.Printf("Error listing templates: %v\n", err)
+			return
+		}
+
+		if len(templates) == 0 {
+			dirs, _ := config.GetTemplateDirs()
+			fmt.Printf("No templates found. Add YAML files to: %s\n", strings.Join(dirs, ", "))
+			return
+		}
+
+		fmt.Printf("Issue templates (Total: %d)\n", len(templates))
+		fmt.Println(strings.Repeat("-", 80))
+
+		for _, tmpl := range templates {
+			summary := tmpl.Summary
+			if summary == "" {
+				summary = "(No summary)"
+			}
+			fmt.Printf("%s | %s | %s\n", tmpl.Name, summary, tmpl.Path)
+
+			var names []string
+			for _, v := range tmpl.Variables {
+				names = append(names, v.Name)
+			}
+			if len(names) > 0 {
+				fmt.Printf("    Variables: %s\n", strings.Join(names, ", "))
+			}
+		}
+	},
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var treeIssueCmd = &cobra.Command{
+	Use:   "tree [issue-id]",
+	Short: "Show the subtasks of an issue as a tree",
+	Long: `Show an issue and its subtasks, recursively, as a tree with the status,
+assignee and done ratio of each issue.
+
+--depth limits the number of levels shown (0 shows all).`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "tree" && format != "json" {
+			fmt.Printf("Invalid format: %s (available: tree, json)\n", format)
+			return
+		}
+		depth, _ := cmd.Flags().GetInt("depth")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetIssue(issueID, "children")
+		if err != nil {
+			fmt.Printf("Error getting issue: %v\n", err)
+			return
+		}
+		issue := response.Issue
+
+		// include=children only returns the ID, tracker and subject of each
+		// subtask, so the rest is loaded in one listing
+		var ids []int
+		collectChildIDs(issue.Children, depth, 1, &ids)
+		details, err := getIssuesByID(c, ids)
+		if err != nil {
+			fmt.Printf("Error getting subtasks: %v\n", err)
+			return
+		}
+
+		root := newIssueTreeNode(issue)
+		root.Children = buildIssueTree(issue.Children, details, depth, 1)
+
+		if format == "json" {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(root); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		}
+
+		printTree([]*treeNode{root.treeNode()})
+		fmt.Printf("\n%d subtasks\n", len(ids))
+	},
+}
+
+var reparentIssueCmd = &cobra.Command{
+	Use:   "reparent [issue-id...]",
+	Short: "Move issues under a new parent",
+	Long: `Move issues under a new parent issue, or make them top-level issues with
+--parent none. Redmine rejects moves that would create a loop or that cross
+projects when subtasks between projects are not allowed.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		parentFlag, _ := cmd.Flags().GetString("parent")
+		if parentFlag == "" {
+			fmt.Println("Give the new parent with --parent <issue-id>, or --parent none to remove the parent")
+			return
+		}
+		var parentID *int
+		if parentFlag != "none" {
+			id, err := parseIssueRef(parentFlag)
+			if err != nil {
+				fmt.Printf("Invalid parent issue ID: %s\n", parentFlag)
+				return
+			}
+			parentID = &id
+		}
+
+		issueIDs := make([]int, 0, len(args))
+		for _, arg := range args {
+			id, err := parseIssueRef(arg)
+			if err != nil {
+				fmt.Printf("Invalid issue ID: %s\n", arg)
+				return
+			}
+			if parentID != nil && id == *parentID {
+				fmt.Printf("#%d cannot be its own parent\n", id)
+				return
+			}
+			issueIDs = append(issueIDs, id)
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		for _, issueID := range issueIDs {
+			if err := c.SetIssueParent(issueID, parentID); err != nil {
+				fmt.Printf("Error moving issue %d: %v\n", issueID, err)
+				continue
+			}
+			if parentID == nil {
+				fmt.Printf("#%d is now a top-level issue\n", issueID)
+			} else {
+				fmt.Printf("#%d moved under #%d\n", issueID, *parentID)
+			}
+		}
+	},
+}
+
+// issueTreeNode is an issue in the subtask tree
+type issueTreeNode struct {
+	ID        int              `json:"id"`
+	Tracker   string           `json:"tracker"`
+	Subject   string           `json:"subject"`
+	Status    string           `json:"status,omitempty"`
+	Assignee  string           `json:"assignee,omitempty"`
+	DoneRatio int              `json:"done_ratio"`
+	Children  []*issueTreeNode `json:"children,omitempty"`
+}
+
+func newIssueTreeNode(issue client.Issue) *issueTreeNode {
+	return &issueTreeNode{
+		ID:        issue.ID,
+		Tracker:   issue.Tracker.Name,
+		Subject:   issue.Subject,
+		Status:    issue.Status.Name,
+		Assignee:  assigneeName(issue),
+		DoneRatio: issue.DoneRatio,
+	}
+}
+
+// collectChildIDs adds the IDs of the subtasks down to depth (0 for all)
+func collectChildIDs(children []client.IssueChild, depth, level int, ids *[]int) {
+	if depth > 0 && level > depth {
+		return
+	}
+	for _, child := range children {
+		*ids = append(*ids, child.ID)
+		collectChildIDs(child.Children, depth, level+1, ids)
+	}
+}
+
+// buildIssueTree builds the nodes of the subtasks down to depth. Subtasks
+// missing from details keep what include=children returned.
+func buildIssueTree(children []client.IssueChild, details map[int]client.Issue, depth, level int) []*issueTreeNode {
+	if depth > 0 && level > depth {
+		return nil
+	}
+	nodes := make([]*issueTreeNode, 0, len(children))
+	for _, child := range children {
+		node := &issueTreeNode{ID: child.ID, Tracker: child.Tracker.Name, Subject: child.Subject}
+		if issue, ok := details[child.ID]; ok {
+			node = newIssueTreeNode(issue)
+		}
+		node.Children = buildIssueTree(child.Children, details, depth, level+1)
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// treeNode converts the node and its children for printTree
+func (n *issueTreeNode) treeNode() *treeNode {
+	label := fmt.Sprintf("#%d [%s] %s", n.ID, n.Tracker, n.Subject)
+	if n.Status != "" {
+		assignee := n.Assignee
+		if assignee == "" {
+			assignee = "Not assigned"
+		}
+		label += fmt.Sprintf(" | %s | %s | %d%%", n.Status, assignee, n.DoneRatio)
+	}
+
+	node := &treeNode{Label: label}
+	for _, child := range n.Children {
+		node.Children = append(node.Children, child.treeNode())
+	}
+	return node
+}
@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var journalsCmd = &cobra.Command{
+	Use:   "journals",
+	Short: "Manage issue comments",
+	Long: `Edit and delete the notes of issue journals (comments). Requires Redmine 5.0 or later.
+
+Journal IDs are shown by 'redmine issues show <issue> --comments'.`,
+}
+
+var editJournalCmd = &cobra.Command{
+	Use:   "edit [journal-id]",
+	Short: "Edit the notes of a journal",
+	Long: `Edit the notes of a journal.
+
+The new notes are given with -m or read from --file ('-' for stdin). Otherwise
+the current notes are opened in $EDITOR, which needs the journal's issue with
+--issue. --private=true or --private=false changes the visibility.
+
+Redmine only lets you edit your own notes, or all notes with the "Edit notes"
+permission.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		journalID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid journal ID: %s\n", args[0])
+			return
+		}
+
+		message, _ := cmd.Flags().GetString("message")
+		file, _ := cmd.Flags().GetString("file")
+		issueRef, _ := cmd.Flags().GetString("issue")
+		if cmd.Flags().Changed("message") && file != "" {
+			fmt.Println("Only one of -m and --file can be used")
+			return
+		}
+
+		req := client.UpdateJournalRequest{}
+		if cmd.Flags().Changed("private") {
+			private, _ := cmd.Flags().GetBool("private")
+			req.Journal.PrivateNotes = &private
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		notes := message
+		visibilityOnly := false
+		switch {
+		case cmd.Flags().Changed("message"):
+		case file != "":
+			notes, err = readTextSource(file, os.Stdin)
+			if err != nil {
+				fmt.Printf("Error reading notes: %v\n", err)
+				return
+			}
+		case issueRef == "" && req.Journal.PrivateNotes != nil:
+			visibilityOnly = true
+		case issueRef == "":
+			fmt.Println("Give the journal's issue with --issue to edit the notes in $EDITOR, or the new notes with -m or --file")
+			return
+		default:
+			if !inputAllowed() {
+				exitMissingInput("notes", "-m or --file")
+			}
+			issueID, err := parseIssueRef(issueRef)
+			if err != nil {
+				fmt.Printf("Invalid issue ID: %s\n", issueRef)
+				return
+			}
+
+			journal, err := findJournal(c, issueID, journalID)
+			if err != nil {
+				fmt.Printf("Error finding journal: %v\n", err)
+				return
+			}
+
+			notes, err = editText(journal.Notes, "journal")
+			if err != nil {
+				fmt.Printf("Error editing notes: %v\n", err)
+				return
+			}
+			if notes == journal.Notes && req.Journal.PrivateNotes == nil {
+				fmt.Println("Notes unchanged, nothing was sent.")
+				return
+			}
+		}
+
+		if !visibilityOnly {
+			if notes == "" {
+				fmt.Printf("The new notes are empty; use 'redmine journals delete %d' to remove the comment\n", journalID)
+				return
+			}
+			req.Journal.Notes = &notes
+		}
+
+		if err := c.UpdateJournal(journalID, req); err != nil {
+			fmt.Printf("Error updating journal %d: %v\n", journalID, journalError(err))
+			return
+		}
+
+		fmt.Printf("Journal %d updated\n", journalID)
+	},
+}
+
+var deleteJournalCmd = &cobra.Command{
+	Use:   "delete [journal-id...]",
+	Short: "Delete the notes of journals",
+	Long: `Delete the notes of journals by clearing them. A journal that also records
+field changes keeps the changes; otherwise Redmine removes it.
+
+You are asked to confirm first, and with --issue the notes to be deleted are
+shown; --yes deletes without asking, which is required when running without input.
+
+Redmine only lets you edit your own notes, or all notes with the "Edit notes"
+permission.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		journalIDs := make([]int, 0, len(args))
+		for _, arg := range args {
+			id, err := strconv.Atoi(arg)
+			if err != nil {
+				fmt.Printf("Invalid journal ID: %s\n", arg)
+				return
+			}
+			journalIDs = append(journalIDs, id)
+		}
+
+		yes, _ := cmd.Flags().GetBool("yes")
+		if !yes && !inputAllowed() {
+			exitMissingInput("confirmation", "--yes")
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		if !yes {
+			issueRef, _ := cmd.Flags().GetString("issue")
+			confirmed, err := confirmJournalDelete(c, issueRef, journalIDs)
+			if err != nil {
+				fmt.Printf("Error finding journal: %v\n", err)
+				return
+			}
+			if !confirmed {
+				fmt.Println("Aborted, nothing was deleted.")
+				return
+			}
+		}
+
+		for _, journalID := range journalIDs {
+			empty := ""
+			req := client.UpdateJournalRequest{Journal: client.UpdateJournalData{Notes: &empty}}
+			if err := c.UpdateJournal(journalID, req); err != nil {
+				fmt.Printf("Error deleting notes of journal %d: %v\n", journalID, journalError(err))
+				continue
+			}
+			fmt.Printf("Deleted notes of journal %d\n", journalID)
+		}
+	},
+}
+
+// confirmJournalDelete shows the notes to be deleted when the issue is known
+// and asks the user to confirm
+func confirmJournalDelete(c *client.Client, issueRef string, journalIDs []int) (bool, error) {
+	if issueRef != "" {
+		issueID, err := parseIssueRef(issueRef)
+		if err != nil {
+			return false, fmt.Errorf("invalid issue ID: %s", issueRef)
+		}
+		for _, journalID := range journalIDs {
+			journal, err := findJournal(c, issueID, journalID)
+			if err != nil {
+				return false, err
+			}
+			fmt.Printf("Journal %d by %s (%s):\n", journal.ID, journal.User.Name, journal.CreatedOn.Local().Format("2006-01-02 15:04"))
+			for _, line := range strings.Split(journal.Notes, "\n") {
+				fmt.Printf("  %s\n", line)
+			}
+		}
+	}
+
+	fmt.Printf("Delete the notes of %d journal(s)? [y/N]: ", len(journalIDs))
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// findJournal looks up a journal of an issue
+func findJournal(c *client.Client, issueID, journalID int) (client.Journal, error) {
+	response, err := c.GetIssue(issueID, "journals")
+	if err != nil {
+		return client.Journal{}, fmt.Errorf("failed to get issue %d: %w", issueID, err)
+	}
+	for _, journal := range response.Issue.Journals {
+		if journal.ID == journalID {
+			return journal, nil
+		}
+	}
+	return client.Journal{}, fmt.Errorf("journal %d not found on issue #%d", journalID, issueID)
+}
+
+// journalError explains the errors Redmine returns for journal updates
+func journalError(err error) error {
+	switch {
+	case client.IsForbidden(err):
+		return fmt.Errorf("not allowed to edit these notes; you need \"Edit own notes\" for your own notes or \"Edit notes\" for others' (%w)", err)
+	case client.IsNotFound(err):
+		return fmt.Errorf("journal not found or not visible; editing journals needs Redmine 5.0 or later (%w)", err)
+	}
+	return err
+}
+
+func init() {
+	rootCmd.AddCommand(journalsCmd)
+	journalsCmd.AddCommand(editJournalCmd)
+	journalsCmd.AddCommand(deleteJournalCmd)
+
+	editJournalCmd.Flags().StringP("message", "m", "", "New notes")
+	editJournalCmd.Flags().String("file", "", "Read the new notes from a file ('-' for stdin)")
+	editJournalCmd.Flags().String("issue", "", "Issue of the journal, to edit the current notes in $EDITOR")
+	editJournalCmd.Flags().Bool("private", false, "Make the notes private (--private=false makes them public)")
+
+	deleteJournalCmd.Flags().String("issue", "", "Issue of the journals, to show their notes before deleting")
+	deleteJournalCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
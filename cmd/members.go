@@ -0,0 +1,506 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var membersCmd = &cobra.Command{
+	Use:   "members",
+	Short: "Manage project members",
+	Long:  `List, add and remove project members and change their roles`,
+}
+
+var listMembersCmd = &cobra.Command{
+	Use:   "list [project]",
+	Short: "List project members",
+	Long:  `List the users and groups that are members of a project (ID or identifier) with their roles`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		memberships, err := c.GetAllMemberships(args[0])
+		if err != nil {
+			fmt.Printf("Error getting memberships: %v\n", err)
+			return
+		}
+
+		if len(memberships) == 0 && format == "table" {
+			fmt.Println("No members found.")
+			return
+		}
+
+		header := []string{"ID", "Type", "User ID", "Roles", "Name"}
+		rows := make([][]string, 0, len(memberships))
+		for _, membership := range memberships {
+			kind, id, name := membershipMember(membership)
+			rows = append(rows, []string{
+				strconv.Itoa(membership.ID),
+				kind,
+				strconv.Itoa(id),
+				formatMembershipRoles(membership.Roles),
+				name,
+			})
+		}
+
+		if format == "table" {
+			fmt.Printf("Members of %s (Total: %d)\n", args[0], len(memberships))
+		}
+		if err := writeOutput(format, header, rows, memberships); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var addMembersCmd = &cobra.Command{
+	Use:   "add [project] [user]",
+	Short: "Add a member to a project",
+	Long: `Add a user or group to a project with the roles given by --role.
+The user is a user or group ID, "me", or an email, login or name (looking up
+others by name requires admin rights).`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		roles, _ := cmd.Flags().GetStringSlice("role")
+		if len(roles) == 0 {
+			fmt.Println("At least one --role is required")
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		roleIDs, err := resolveRoleIDs(c, roles)
+		if err != nil {
+			fmt.Printf("Error resolving roles: %v\n", err)
+			return
+		}
+
+		userID, err := strconv.Atoi(args[1])
+		if err != nil {
+			userID, err = resolveUserID(c, args[1])
+			if err != nil {
+				fmt.Printf("Error resolving user: %v\n", err)
+				return
+			}
+		}
+
+		response, err := c.CreateMembership(args[0], client.CreateMembershipRequest{
+			Membership: client.CreateMembershipData{UserID: userID, RoleIDs: roleIDs},
+		})
+		if err != nil {
+			fmt.Printf("Error adding member: %v\n", err)
+			return
+		}
+
+		_, _, name := membershipMember(response.Membership)
+		fmt.Printf("Member added successfully: %d | %s | %s\n", response.Membership.ID, name, formatMembershipRoles(response.Membership.Roles))
+	},
+}
+
+var removeMembersCmd = &cobra.Command{
+	Use:   "remove [project] [member]",
+	Short: "Remove a member from a project",
+	Long:  `Remove a user or group (by user/group ID or name) from a project`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		membership, err := findMembership(c, args[0], args[1])
+		if err != nil {
+			fmt.Printf("Error finding member: %v\n", err)
+			return
+		}
+
+		if err := c.DeleteMembership(membership.ID); err != nil {
+			fmt.Printf("Error removing member: %v\n", err)
+			return
+		}
+
+		_, _, name := membershipMember(*membership)
+		fmt.Printf("%s removed from %s\n", name, args[0])
+	},
+}
+
+var setRolesMembersCmd = &cobra.Command{
+	Use:   "set-roles [project] [member] [role...]",
+	Short: "Replace the roles of a project member",
+	Long: `Replace the roles of a user or group (by user/group ID or name) in a project.
+Inherited roles are kept by Redmine.`,
+	Args: cobra.MinimumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		roleIDs, err := resolveRoleIDs(c, args[2:])
+		if err != nil {
+			fmt.Printf("Error resolving roles: %v\n", err)
+			return
+		}
+
+		membership, err := findMembership(c, args[0], args[1])
+		if err != nil {
+			fmt.Printf("Error finding member: %v\n", err)
+			return
+		}
+
+		if err := c.UpdateMembership(membership.ID, client.UpdateMembershipRequest{
+			Membership: client.UpdateMembershipData{RoleIDs: roleIDs},
+		}); err != nil {
+			fmt.Printf("Error updating roles: %v\n", err)
+			return
+		}
+
+		_, _, name := membershipMember(*membership)
+		fmt.Printf("Roles of %s in %s set to %s\n", name, args[0], strings.Join(args[2:], ", "))
+	},
+}
+
+// membershipMember returns the kind ("user" or "group"), ID and name of the member
+func membershipMember(membership client.Membership) (string, int, string) {
+	if membership.Group != nil {
+		return "group", membership.Group.ID, membership.Group.Name
+	}
+	if membership.User != nil {
+		return "user", membership.User.ID, membership.User.Name
+	}
+	return "", 0, ""
+}
+
+// formatMembershipRoles lists role names, marking inherited roles with '*'
+func formatMembershipRoles(roles []client.MembershipRole) string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		name := role.Name
+		if role.Inherited {
+			name += "*"
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+// findMembership looks up the membership of a user or group in a project
+// by user/group ID or name
+func findMembership(c *client.Client, project, member string) (*client.Membership, error) {
+	memberships, err := c.GetAllMemberships(project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memberships: %w", err)
+	}
+
+	for i, membership := range memberships {
+		_, id, name := membershipMember(membership)
+		if strconv.Itoa(id) == member || strings.EqualFold(name, member) {
+			return &memberships[i], nil
+		}
+	}
+	return nil, fmt.Errorf("'%s' is not a member of %s", member, project)
+}
+
+// resolveRoleIDs looks up roles by ID or name
+func resolveRoleIDs(c *client.Client, values []string) ([]int, error) {
+	rolesResp, err := c.GetRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, value := range values {
+		found := false
+		var names []string
+		for _, role := range rolesResp.Roles {
+			if strconv.Itoa(role.ID) == value || strings.EqualFold(role.Name, value) {
+				ids = append(ids, role.ID)
+				found = true
+				break
+			}
+			names = append(names, role.Name)
+		}
+		if !found {
+			return nil, fmt.Errorf("role '%s' not found (available: %s)", value, strings.Join(names, ", "))
+		}
+	}
+	return ids, nil
+}
+
+// resolveUserID looks up a user by "me", email, login or name through the
+// users endpoint (admin only)
+func resolveUserID(c *client.Client, value string) (int, error) {
+	currentUserResp, err := c.GetCurrentUser()
+	if err == nil && (value == "me" || userMatches(currentUserResp.User, value)) {
+		return currentUserResp.User.ID, nil
+	}
+
+	users, err := c.GetAllUsers(nil)
+	if err != nil {
+		if client.IsForbidden(err) {
+			return 0, fmt.Errorf("looking up '%s' requires admin rights; use the user ID instead", value)
+		}
+		return 0, err
+	}
+	if user, ok := findUser(users, value); ok {
+		return user.ID, nil
+	}
+	return 0, fmt.Errorf("user '%s' not found", value)
+}
+
+// assignableUsers returns the users an issue of the project can be assigned
+// to. The users endpoint requires admin rights, so when it is forbidden the
+// project's memberships are used instead; groups are then included by name.
+func assignableUsers(c *client.Client, project string) ([]client.User, error) {
+	users, err := c.GetAllUsers(nil)
+	if err == nil {
+		return users, nil
+	}
+	if !client.IsForbidden(err) {
+		return nil, err
+	}
+	return memberUsers(c, project)
+}
+
+// memberUsers returns the users and groups that are members of the project.
+// Only IDs and names are available from memberships.
+func memberUsers(c *client.Client, project string) ([]client.User, error) {
+	memberships, err := c.GetAllMemberships(project)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool)
+	var users []client.User
+	for _, membership := range memberships {
+		_, id, name := membershipMember(membership)
+		if id == 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		users = append(users, client.User{ID: id, Name: name})
+	}
+	return users, nil
+}
+
+// resolveAssignee looks up the user or group to assign an issue of the
+// project to. Commands resolving several users share a userResolver instead.
+func resolveAssignee(c *client.Client, project, value string) (int, error) {
+	return newUserResolver(c).resolve(project, value)
+}
+
+// userResolver looks up assignees, watchers and user custom field values:
+// "me", a user ID, or an email, login or name. The current user, the users
+// list and each project's members are loaded once.
+type userResolver struct {
+	c *client.Client
+
+	currentLoaded bool
+	current       *client.User
+
+	usersLoaded bool
+	users       []client.User
+	usersErr    error
+
+	members    map[string][]client.User
+	membersErr map[string]error
+}
+
+func newUserResolver(c *client.Client) *userResolver {
+	return &userResolver{
+		c:          c,
+		members:    make(map[string][]client.User),
+		membersErr: make(map[string]error),
+	}
+}
+
+// resolve finds a user or group for the project. Project members come
+// first, as only they can be assigned; the users endpoint (admin only) adds
+// lookups by email and login, preferring users who are members.
+func (r *userResolver) resolve(project, value string) (int, error) {
+	if current := r.currentUser(); current != nil && (value == "me" || userMatches(*current, value)) {
+		return current.ID, nil
+	}
+
+	members, membersErr := r.projectMembers(project)
+	if user, ok := findUser(members, value); ok {
+		return user.ID, nil
+	}
+
+	users, err := r.allUsers()
+	if err != nil && !client.IsForbidden(err) {
+		return 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	var match *client.User
+	for i, user := range users {
+		if !userMatches(user, value) {
+			continue
+		}
+		if match == nil {
+			match = &users[i]
+		}
+		if _, ok := findUser(members, strconv.Itoa(user.ID)); ok {
+			return user.ID, nil
+		}
+	}
+	if match != nil {
+		return match.ID, nil
+	}
+
+	if membersErr != nil {
+		return 0, fmt.Errorf("user '%s' not found (failed to list project members: %v)", value, membersErr)
+	}
+	if err != nil && strings.Contains(value, "@") {
+		return 0, fmt.Errorf("user '%s' not found; emails can only be looked up with admin rights, use the member's name or ID (see 'redmine members list')", value)
+	}
+	return 0, fmt.Errorf("user '%s' not found", value)
+}
+
+func (r *userResolver) currentUser() *client.User {
+	if !r.currentLoaded {
+		r.currentLoaded = true
+		if response, err := r.c.GetCurrentUser(); err == nil {
+			r.current = &response.User
+		}
+	}
+	return r.current
+}
+
+func (r *userResolver) allUsers() ([]client.User, error) {
+	if !r.usersLoaded {
+		r.usersLoaded = true
+		r.users, r.usersErr = r.c.GetAllUsers(nil)
+	}
+	return r.users, r.usersErr
+}
+
+func (r *userResolver) projectMembers(project string) ([]client.User, error) {
+	if _, ok := r.members[project]; !ok {
+		if _, failed := r.membersErr[project]; !failed {
+			members, err := memberUsers(r.c, project)
+			if err != nil {
+				r.membersErr[project] = err
+				return nil, err
+			}
+			if members == nil {
+				members = []client.User{}
+			}
+			r.members[project] = members
+		}
+	}
+	return r.members[project], r.membersErr[project]
+}
+
+// userMatches reports whether value is the ID, email, login or name of user
+func userMatches(user client.User, value string) bool {
+	_, ok := findUser([]client.User{user}, value)
+	return ok
+}
+
+func init() {
+	rootCmd.AddCommand(membersCmd)
+	membersCmd.AddCommand(listMembersCmd)
+	membersCmd.AddCommand(addMembersCmd)
+	membersCmd.AddCommand(removeMembersCmd)
+	membersCmd.AddCommand(setRolesMembersCmd)
+
+	listMembersCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	addMembersCmd.Flags().StringSlice("role", nil, "Role names or IDs to give the member")
+}
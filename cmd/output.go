@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// outputFormats lists the formats accepted by commands with a --format flag
+var outputFormats = []string{"table", "csv", "json"}
+
+// validateOutputFormat checks a --format value
+func validateOutputFormat(format string) error {
+	for _, f := range outputFormats {
+		if f == format {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid format: %s (available: %s)", format, strings.Join(outputFormats, ", "))
+}
+
+// writeOutput prints rows as an aligned table or CSV, or prints data as JSON
+func writeOutput(format string, header []string, rows [][]string, data interface{}) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	case "csv":
+		writer := csv.NewWriter(os.Stdout)
+		if err := writer.Write(header); err != nil {
+			return err
+		}
+		if err := writer.WriteAll(rows); err != nil {
+			return err
+		}
+		return writer.Error()
+	default:
+		printTable(header, rows)
+		return nil
+	}
+}
+
+// printTable prints rows in the same column layout as 'issues list'.
+// The last column is never padded so long text such as subjects can run on.
+func printTable(header []string, rows [][]string) {
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) && utf8.RuneCountInString(cell) > widths[i] {
+				widths[i] = utf8.RuneCountInString(cell)
+			}
+		}
+	}
+
+	printRow := func(cells []string) {
+		parts := make([]string, len(cells))
+		for i, cell := range cells {
+			if i == len(cells)-1 {
+				parts[i] = cell
+			} else {
+				parts[i] = cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			}
+		}
+		fmt.Println(strings.Join(parts, " | "))
+	}
+
+	printRow(header)
+	separator := make([]string, len(header))
+	for i := range header {
+		separator[i] = strings.Repeat("-", widths[i])
+	}
+	fmt.Println(strings.Join(separator, "-|-"))
+	for _, row := range rows {
+		printRow(row)
+	}
+}
+
+// treeNode is an entry printed by printTree
+type treeNode struct {
+	Label    string
+	Children []*treeNode
+}
+
+// printTree prints nodes and their children with box-drawing branches
+func printTree(nodes []*treeNode) {
+	for _, node := range nodes {
+		fmt.Println(node.Label)
+		printTreeChildren(node.Children, "")
+	}
+}
+
+func printTreeChildren(nodes []*treeNode, prefix string) {
+	for i, node := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Println(prefix + branch + node.Label)
+		printTreeChildren(node.Children, prefix+indent)
+	}
+}
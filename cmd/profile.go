@@ -172,6 +172,62 @@ var profileShowCmd = &cobra.Command{
 		}
 		fmt.Printf("Redmine URL: %s\n", profile.RedmineURL)
 		fmt.Printf("API Key: %s\n", maskAPIKey(profile.APIKey))
+		fmt.Printf("Timer increment: %d minutes\n", profile.GetTimerIncrement())
+		if profile.TimesheetMinHours > 0 {
+			fmt.Printf("Timesheet minimum: %s hours/day\n", formatHoursValue(profile.TimesheetMinHours))
+		}
+		for _, action := range statusShortcuts {
+			if status := profile.StatusShortcuts[action]; status != "" {
+				fmt.Printf("Status for 'issues %s': %s\n", action, status)
+			}
+		}
+	},
+}
+
+var profileSetCmd = &cobra.Command{
+	Use:   "set [key] [value]",
+	Short: "Change a profile setting",
+	Long: `Change a setting of the default profile.
+
+Available settings:
+  timer_increment      Rounding increment in minutes for 'timer stop' (default: 15)
+  timesheet_min_hours  Flag timesheet days with fewer hours (default: 0, disabled)
+  close_status         Status (name or ID) for 'issues close'
+  reopen_status        Status (name or ID) for 'issues reopen'
+  start_status         Status (name or ID) for 'issues start'
+  resolve_status       Status (name or ID) for 'issues resolve'
+
+Without a status setting, the shortcuts use the status named Closed, New,
+In Progress or Resolved. An empty value removes a status setting.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		key := args[0]
+		value := args[1]
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if err := cfg.SetProfileOption(profile.Name, key, value); err != nil {
+			fmt.Printf("Error setting option: %v\n", err)
+			return
+		}
+
+		if err := cfg.Save(); err != nil {
+			fmt.Printf("Error saving config: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Set %s = %s for profile '%s'\n", key, value, profile.Name)
 	},
 }
 
@@ -182,4 +238,5 @@ func init() {
 	profileCmd.AddCommand(profileUseCmd)
 	profileCmd.AddCommand(profileRemoveCmd)
 	profileCmd.AddCommand(profileShowCmd)
+	profileCmd.AddCommand(profileSetCmd)
 }
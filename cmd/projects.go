@@ -0,0 +1,683 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// projectIncludes are the associations 'projects show' always requests
+var projectIncludes = []string{"trackers", "issue_categories", "enabled_modules", "time_entry_activities"}
+
+var projectsCmd = &cobra.Command{
+	Use:   "projects",
+	Short: "Manage Redmine projects",
+	Long:  `List, view, create, update and archive Redmine projects`,
+}
+
+var listProjectsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List projects",
+	Long: `List all projects visible to you, paging through every result.
+
+--include adds associations (trackers, issue_categories, enabled_modules,
+time_entry_activities) to the output.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		params, err := projectListParams(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		projects, err := c.GetAllProjects(params)
+		if err != nil {
+			fmt.Printf("Error getting projects: %v\n", err)
+			return
+		}
+
+		if len(projects) == 0 && format == "table" {
+			fmt.Println("No projects found.")
+			return
+		}
+
+		include := params["include"]
+		header := []string{"ID", "Identifier", "Parent", "Status"}
+		if strings.Contains(include, "trackers") {
+			header = append(header, "Trackers")
+		}
+		if strings.Contains(include, "enabled_modules") {
+			header = append(header, "Modules")
+		}
+		header = append(header, "Name")
+
+		rows := make([][]string, 0, len(projects))
+		for _, project := range projects {
+			parent := ""
+			if project.Parent != nil {
+				parent = project.Parent.Name
+			}
+			row := []string{strconv.Itoa(project.ID), project.Identifier, parent, projectStatusName(project.Status)}
+			if strings.Contains(include, "trackers") {
+				var names []string
+				for _, tracker := range project.Trackers {
+					names = append(names, tracker.Name)
+				}
+				row = append(row, strings.Join(names, ", "))
+			}
+			if strings.Contains(include, "enabled_modules") {
+				var names []string
+				for _, module := range project.EnabledModules {
+					names = append(names, module.Name)
+				}
+				row = append(row, strings.Join(names, ", "))
+			}
+			rows = append(rows, append(row, project.Name))
+		}
+
+		if format == "table" {
+			fmt.Printf("Projects (Total: %d)\n", len(projects))
+		}
+		if err := writeOutput(format, header, rows, projects); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var showProjectCmd = &cobra.Command{
+	Use:   "show [project]",
+	Short: "Show project details",
+	Long:  `Show a project by ID or identifier with its trackers, issue categories, enabled modules and time entry activities`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "table" && format != "json" {
+			fmt.Printf("invalid format: %s (available: table, json)\n", format)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetProject(args[0], projectIncludes...)
+		if err != nil {
+			fmt.Printf("Error getting project: %v\n", err)
+			return
+		}
+
+		project := response.Project
+		if format == "json" {
+			if err := writeOutput(format, nil, nil, project); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		}
+
+		fmt.Printf("Project #%d\n", project.ID)
+		fmt.Println(strings.Repeat("=", 50))
+		fmt.Printf("Name: %s\n", project.Name)
+		fmt.Printf("Identifier: %s\n", project.Identifier)
+		if project.Parent != nil {
+			fmt.Printf("Parent: %s (#%d)\n", project.Parent.Name, project.Parent.ID)
+		}
+		fmt.Printf("Status: %s\n", projectStatusName(project.Status))
+		if project.IsPublic != nil {
+			fmt.Printf("Public: %t\n", *project.IsPublic)
+		}
+		if project.InheritMembers != nil {
+			fmt.Printf("Inherit members: %t\n", *project.InheritMembers)
+		}
+		if project.Homepage != "" {
+			fmt.Printf("Homepage: %s\n", project.Homepage)
+		}
+		if project.CreatedOn != nil {
+			fmt.Printf("Created: %s\n", project.CreatedOn.Format("2006-01-02 15:04:05"))
+		}
+		if project.UpdatedOn != nil {
+			fmt.Printf("Updated: %s\n", project.UpdatedOn.Format("2006-01-02 15:04:05"))
+		}
+
+		if project.Description != "" {
+			fmt.Println("\nDescription:")
+			fmt.Println(strings.Repeat("-", 20))
+			fmt.Println(project.Description)
+		}
+
+		var trackers, categories, modules, activities []string
+		for _, tracker := range project.Trackers {
+			trackers = append(trackers, tracker.Name)
+		}
+		for _, category := range project.IssueCategories {
+			categories = append(categories, category.Name)
+		}
+		for _, module := range project.EnabledModules {
+			modules = append(modules, module.Name)
+		}
+		for _, activity := range project.TimeEntryActivities {
+			activities = append(activities, activity.Name)
+		}
+
+		fmt.Println()
+		fmt.Printf("Trackers: %s\n", joinOrNone(trackers))
+		fmt.Printf("Issue categories: %s\n", joinOrNone(categories))
+		fmt.Printf("Enabled modules: %s\n", joinOrNone(modules))
+		fmt.Printf("Time entry activities: %s\n", joinOrNone(activities))
+	},
+}
+
+var treeProjectsCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Show the project hierarchy",
+	Long:  `Show all projects visible to you as a tree of subprojects`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		params, err := projectListParams(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		projects, err := c.GetAllProjects(params)
+		if err != nil {
+			fmt.Printf("Error getting projects: %v\n", err)
+			return
+		}
+
+		if len(projects) == 0 {
+			fmt.Println("No projects found.")
+			return
+		}
+
+		printTree(projectTree(projects))
+	},
+}
+
+var createProjectCmd = &cobra.Command{
+	Use:   "create [name]",
+	Short: "Create a project",
+	Long: `Create a new project. The identifier is derived from the name unless --identifier is given;
+names without ASCII letters, such as Japanese names, need --identifier.
+--parent accepts a project ID or identifier.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		identifier, _ := cmd.Flags().GetString("identifier")
+		if identifier == "" {
+			identifier = projectIdentifier(args[0])
+			// Redmine identifiers need a letter, "-" or "_"; names such as
+			// Japanese ones leave nothing usable
+			if identifier == "" || allDigits(identifier) {
+				fmt.Printf("Cannot derive a project identifier from '%s'; give one with --identifier (lowercase letters, digits, '-' and '_', not only digits)\n", args[0])
+				return
+			}
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		data := client.CreateProjectData{
+			Name:       args[0],
+			Identifier: identifier,
+		}
+		data.Description, _ = cmd.Flags().GetString("description")
+		data.Homepage, _ = cmd.Flags().GetString("homepage")
+		if cmd.Flags().Changed("public") {
+			public, _ := cmd.Flags().GetBool("public")
+			data.IsPublic = &public
+		}
+		if cmd.Flags().Changed("inherit-members") {
+			inherit, _ := cmd.Flags().GetBool("inherit-members")
+			data.InheritMembers = &inherit
+		}
+		data.EnabledModuleNames, _ = cmd.Flags().GetStringSlice("modules")
+
+		if parent, _ := cmd.Flags().GetString("parent"); parent != "" {
+			parentResp, err := c.GetProject(parent)
+			if err != nil {
+				fmt.Printf("Error getting parent project: %v\n", err)
+				return
+			}
+			data.ParentID = parentResp.Project.ID
+		}
+
+		if trackers, _ := cmd.Flags().GetStringSlice("trackers"); len(trackers) > 0 {
+			data.TrackerIDs, err = resolveTrackerIDs(c, trackers)
+			if err != nil {
+				fmt.Printf("Error resolving trackers: %v\n", err)
+				return
+			}
+		}
+
+		response, err := c.CreateProject(client.CreateProjectRequest{Project: data})
+		if err != nil {
+			fmt.Printf("Error creating project: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Project created successfully: #%d %s (%s)\n", response.Project.ID, response.Project.Name, response.Project.Identifier)
+	},
+}
+
+var updateProjectCmd = &cobra.Command{
+	Use:   "update [project]",
+	Short: "Update a project",
+	Long:  `Update a project given its ID or identifier. Only the given flags are changed.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		var data client.UpdateProjectData
+		hasChanges := false
+
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			data.Name = &name
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("description") {
+			description, _ := cmd.Flags().GetString("description")
+			data.Description = &description
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("homepage") {
+			homepage, _ := cmd.Flags().GetString("homepage")
+			data.Homepage = &homepage
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("public") {
+			public, _ := cmd.Flags().GetBool("public")
+			data.IsPublic = &public
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("inherit-members") {
+			inherit, _ := cmd.Flags().GetBool("inherit-members")
+			data.InheritMembers = &inherit
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("modules") {
+			data.EnabledModuleNames, _ = cmd.Flags().GetStringSlice("modules")
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("parent") {
+			parent, _ := cmd.Flags().GetString("parent")
+			parentResp, err := c.GetProject(parent)
+			if err != nil {
+				fmt.Printf("Error getting parent project: %v\n", err)
+				return
+			}
+			data.ParentID = &parentResp.Project.ID
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("trackers") {
+			trackers, _ := cmd.Flags().GetStringSlice("trackers")
+			data.TrackerIDs, err = resolveTrackerIDs(c, trackers)
+			if err != nil {
+				fmt.Printf("Error resolving trackers: %v\n", err)
+				return
+			}
+			hasChanges = true
+		}
+
+		if !hasChanges {
+			fmt.Println("No changes specified. Use --help to see available options.")
+			return
+		}
+
+		if err := c.UpdateProject(args[0], client.UpdateProjectRequest{Project: data}); err != nil {
+			fmt.Printf("Error updating project: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Project %s updated successfully\n", args[0])
+	},
+}
+
+var archiveProjectCmd = &cobra.Command{
+	Use:   "archive [project]",
+	Short: "Archive a project",
+	Long:  `Archive a project given its ID or identifier (requires Redmine 5.0 or later)`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runProjectArchive(args[0], true)
+	},
+}
+
+var unarchiveProjectCmd = &cobra.Command{
+	Use:   "unarchive [project]",
+	Short: "Unarchive a project",
+	Long:  `Unarchive a project given its ID or identifier (requires Redmine 5.0 or later)`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runProjectArchive(args[0], false)
+	},
+}
+
+func runProjectArchive(project string, archive bool) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	profile, err := cfg.GetCurrentProfile()
+	if err != nil {
+		fmt.Printf("Error getting current profile: %v\n", err)
+		fmt.Println("Please add a profile using 'redmine profile add'")
+		return
+	}
+
+	if profile.APIKey == "" {
+		fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	if profile.RedmineURL == "" {
+		fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+	if archive {
+		if err := c.ArchiveProject(project); err != nil {
+			fmt.Printf("Error archiving project: %v\n", err)
+			return
+		}
+		fmt.Printf("Project %s archived\n", project)
+		return
+	}
+
+	if err := c.UnarchiveProject(project); err != nil {
+		fmt.Printf("Error unarchiving project: %v\n", err)
+		return
+	}
+	fmt.Printf("Project %s unarchived\n", project)
+}
+
+// projectStatusParams maps --status values to the status filter of /projects.json
+var projectStatusParams = map[string]string{
+	"active":   strconv.Itoa(client.ProjectStatusActive),
+	"closed":   strconv.Itoa(client.ProjectStatusClosed),
+	"archived": strconv.Itoa(client.ProjectStatusArchived),
+}
+
+// projectListParams builds query parameters from the --status and --include flags
+func projectListParams(cmd *cobra.Command) (map[string]string, error) {
+	params := make(map[string]string)
+
+	if status, _ := cmd.Flags().GetString("status"); status != "" {
+		value, ok := projectStatusParams[status]
+		if !ok {
+			return nil, fmt.Errorf("invalid status: %s (available: active, closed, archived)", status)
+		}
+		params["status"] = value
+	}
+
+	if include, _ := cmd.Flags().GetStringSlice("include"); len(include) > 0 {
+		for _, name := range include {
+			if !containsString(projectIncludes, name) {
+				return nil, fmt.Errorf("invalid include: %s (available: %s)", name, strings.Join(projectIncludes, ", "))
+			}
+		}
+		params["include"] = strings.Join(include, ",")
+	}
+
+	return params, nil
+}
+
+func projectStatusName(status int) string {
+	switch status {
+	case client.ProjectStatusActive:
+		return "active"
+	case client.ProjectStatusClosed:
+		return "closed"
+	case client.ProjectStatusArchived:
+		return "archived"
+	case 0:
+		return ""
+	default:
+		return strconv.Itoa(status)
+	}
+}
+
+// projectTree arranges projects by parent. Projects whose parent is not
+// visible are shown at the top level.
+func projectTree(projects []client.Project) []*treeNode {
+	nodes := make(map[int]*treeNode, len(projects))
+	for _, project := range projects {
+		label := fmt.Sprintf("%s (%s)", project.Name, project.Identifier)
+		if project.Status != 0 && project.Status != client.ProjectStatusActive {
+			label += " [" + projectStatusName(project.Status) + "]"
+		}
+		nodes[project.ID] = &treeNode{Label: label}
+	}
+
+	sorted := make([]client.Project, len(projects))
+	copy(sorted, projects)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+
+	var roots []*treeNode
+	for _, project := range sorted {
+		node := nodes[project.ID]
+		if project.Parent != nil {
+			if parent, ok := nodes[project.Parent.ID]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
+
+var identifierInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// projectIdentifier derives a project identifier from a name, e.g.
+// "Web Site 2" becomes "web-site-2"
+func projectIdentifier(name string) string {
+	identifier := identifierInvalidChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.Trim(identifier, "-")
+}
+
+func allDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// resolveTrackerIDs looks up trackers by ID or name
+func resolveTrackerIDs(c *client.Client, values []string) ([]int, error) {
+	trackersResp, err := c.GetTrackers()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, value := range values {
+		tracker, ok := findTracker(trackersResp.Trackers, value)
+		if !ok {
+			return nil, fmt.Errorf("tracker '%s' not found", value)
+		}
+		ids = append(ids, tracker.ID)
+	}
+	return ids, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "(none)"
+	}
+	return strings.Join(values, ", ")
+}
+
+func init() {
+	rootCmd.AddCommand(projectsCmd)
+	projectsCmd.AddCommand(listProjectsCmd)
+	projectsCmd.AddCommand(showProjectCmd)
+	projectsCmd.AddCommand(treeProjectsCmd)
+	projectsCmd.AddCommand(createProjectCmd)
+	projectsCmd.AddCommand(updateProjectCmd)
+	projectsCmd.AddCommand(archiveProjectCmd)
+	projectsCmd.AddCommand(unarchiveProjectCmd)
+
+	for _, c := range []*cobra.Command{listProjectsCmd, treeProjectsCmd} {
+		c.Flags().String("status", "", "Only projects with this status (active, closed, archived)")
+	}
+	listProjectsCmd.Flags().StringSlice("include", nil, "Associations to include (trackers, issue_categories, enabled_modules, time_entry_activities)")
+	listProjectsCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	showProjectCmd.Flags().String("format", "table", "Output format (table, json)")
+
+	createProjectCmd.Flags().String("identifier", "", "Project identifier (default: derived from the name)")
+	updateProjectCmd.Flags().String("name", "", "New project name")
+	for _, c := range []*cobra.Command{createProjectCmd, updateProjectCmd} {
+		c.Flags().String("description", "", "Project description")
+		c.Flags().String("homepage", "", "Project homepage")
+		c.Flags().String("parent", "", "Parent project ID or identifier")
+		c.Flags().Bool("public", false, "Make the project public (--public=false for private)")
+		c.Flags().Bool("inherit-members", false, "Inherit members from the parent project")
+		c.Flags().StringSlice("trackers", nil, "Tracker names or IDs to enable")
+		c.Flags().StringSlice("modules", nil, "Module names to enable (e.g. issue_tracking,time_tracking,wiki)")
+	}
+}
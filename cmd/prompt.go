@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxVisibleOptions is the number of options shown at once by selectOption
+const maxVisibleOptions = 10
+
+var errSelectionCancelled = errors.New("selection cancelled")
+
+// inputAllowed reports whether the command may prompt the user. Prompting is
+// disabled by --no-input, by REDMINE_NO_INPUT, or when stdin is not a terminal.
+func inputAllowed() bool {
+	if noInputFlag {
+		return false
+	}
+	if value := os.Getenv("REDMINE_NO_INPUT"); value != "" {
+		if disabled, err := strconv.ParseBool(value); err != nil || disabled {
+			return false
+		}
+	}
+	return isTerminal(os.Stdin)
+}
+
+// exitMissingInput reports a required value that could not be prompted for
+// and exits with a non-zero status so scripts fail loudly
+func exitMissingInput(what, flag string) {
+	fmt.Fprintf(os.Stderr, "Error: %s is required when running without input; specify %s\n", what, flag)
+	os.Exit(1)
+}
+
+// selectOption lets the user pick one of options with the arrow keys and
+// type-to-filter. It falls back to a numbered list when the terminal cannot
+// be switched to raw mode.
+func selectOption(reader *bufio.Reader, label string, options []string) (int, error) {
+	if len(options) == 0 {
+		return 0, fmt.Errorf("no %s available", strings.ToLower(label))
+	}
+
+	restore, err := enableRawMode()
+	if err != nil {
+		return selectOptionByNumber(reader, label, options)
+	}
+	defer restore()
+
+	filter := ""
+	cursor := 0
+	drawnLines := 0
+
+	matches := func() []int {
+		var indexes []int
+		needle := strings.ToLower(filter)
+		for i, option := range options {
+			if strings.Contains(strings.ToLower(option), needle) {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes
+	}
+
+	draw := func(indexes []int) {
+		if drawnLines > 0 {
+			fmt.Printf("\x1b[%dA", drawnLines)
+		}
+		fmt.Print("\r\x1b[J")
+
+		fmt.Printf("%s: %s\r\n", label, filter)
+		drawnLines = 1
+
+		start := 0
+		if cursor >= maxVisibleOptions {
+			start = cursor - maxVisibleOptions + 1
+		}
+		for i := start; i < len(indexes) && i < start+maxVisibleOptions; i++ {
+			marker := "  "
+			if i == cursor {
+				marker = "> "
+			}
+			fmt.Printf("%s%s\r\n", marker, options[indexes[i]])
+			drawnLines++
+		}
+		if len(indexes) == 0 {
+			fmt.Print("  (no matches)\r\n")
+			drawnLines++
+		}
+		fmt.Print("\x1b[2m  ↑/↓ to move, type to filter, Enter to select, Esc to cancel\x1b[0m")
+	}
+
+	indexes := matches()
+	buf := make([]byte, 16)
+	for {
+		draw(indexes)
+
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			return 0, err
+		}
+		key := buf[:n]
+
+		switch {
+		case n == 1 && (key[0] == 3 || key[0] == 4 || key[0] == 27):
+			fmt.Print("\r\n")
+			return 0, errSelectionCancelled
+		case n == 1 && (key[0] == '\r' || key[0] == '\n'):
+			if len(indexes) == 0 {
+				continue
+			}
+			selected := indexes[cursor]
+			fmt.Printf("\x1b[%dA\r\x1b[J%s: %s\r\n", drawnLines, label, options[selected])
+			return selected, nil
+		case n == 1 && (key[0] == 127 || key[0] == 8):
+			if filter != "" {
+				_, size := utf8.DecodeLastRuneInString(filter)
+				filter = filter[:len(filter)-size]
+				indexes = matches()
+				cursor = 0
+			}
+		case n >= 3 && key[0] == 27 && key[1] == '[':
+			switch key[2] {
+			case 'A':
+				if cursor > 0 {
+					cursor--
+				}
+			case 'B':
+				if cursor < len(indexes)-1 {
+					cursor++
+				}
+			}
+		case key[0] >= 32 && utf8.Valid(key):
+			filter += string(key)
+			indexes = matches()
+			cursor = 0
+		}
+	}
+}
+
+// selectOptionByNumber prints a numbered list and reads the choice as a line
+func selectOptionByNumber(reader *bufio.Reader, label string, options []string) (int, error) {
+	fmt.Printf("Available %ss:\n", strings.ToLower(label))
+	for i, option := range options {
+		fmt.Printf("%d. %s\n", i+1, option)
+	}
+	fmt.Printf("Select %s number: ", strings.ToLower(label))
+
+	input, _ := reader.ReadString('\n')
+	index, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || index < 1 || index > len(options) {
+		return 0, fmt.Errorf("invalid %s selection", strings.ToLower(label))
+	}
+	return index - 1, nil
+}
+
+// enableRawMode switches the terminal to raw mode using stty and returns a
+// function restoring the previous state
+func enableRawMode() (func(), error) {
+	getState := exec.Command("stty", "-g")
+	getState.Stdin = os.Stdin
+	state, err := getState.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	raw := exec.Command("stty", "raw", "-echo")
+	raw.Stdin = os.Stdin
+	if err := raw.Run(); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		restore := exec.Command("stty", strings.TrimSpace(string(state)))
+		restore.Stdin = os.Stdin
+		restore.Run()
+	}, nil
+}
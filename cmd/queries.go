@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var queriesCmd = &cobra.Command{
+	Use:   "queries",
+	Short: "Manage saved issue queries",
+	Long:  `List the saved issue queries defined in the Redmine web UI`,
+}
+
+var listQueriesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved queries",
+	Long: `List the saved issue queries visible to you. With --project only the
+queries of that project and the global queries are listed.
+
+Run a query with 'redmine issues list --query <name>'.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+		project, _ := cmd.Flags().GetString("project")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		queries, err := getProjectQueries(c, project)
+		if err != nil {
+			fmt.Printf("Error getting queries: %v\n", err)
+			return
+		}
+
+		if len(queries) == 0 && format == "table" {
+			fmt.Println("No saved queries found.")
+			return
+		}
+
+		projects, err := c.GetAllProjects(nil)
+		if err != nil {
+			fmt.Printf("Error getting projects: %v\n", err)
+			return
+		}
+		projectNames := make(map[int]string, len(projects))
+		for _, p := range projects {
+			projectNames[p.ID] = p.Name
+		}
+
+		header := []string{"ID", "Public", "Project", "Name"}
+		rows := make([][]string, 0, len(queries))
+		for _, query := range queries {
+			projectName := "(all projects)"
+			if query.ProjectID != nil {
+				projectName = projectNames[*query.ProjectID]
+				if projectName == "" {
+					projectName = strconv.Itoa(*query.ProjectID)
+				}
+			}
+			rows = append(rows, []string{strconv.Itoa(query.ID), formatYesNo(query.IsPublic), projectName, query.Name})
+		}
+
+		if format == "table" {
+			fmt.Printf("Saved queries (Total: %d)\n", len(queries))
+		}
+		if err := writeOutput(format, header, rows, queries); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+// getProjectQueries returns the saved queries usable in a project: its own
+// queries and the global ones. An empty project returns every query.
+func getProjectQueries(c *client.Client, project string) ([]client.Query, error) {
+	queries, err := c.GetAllQueries()
+	if err != nil {
+		return nil, err
+	}
+	if project == "" {
+		return queries, nil
+	}
+
+	response, err := c.GetProject(project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", project, err)
+	}
+
+	var matching []client.Query
+	for _, query := range queries {
+		if query.ProjectID == nil || *query.ProjectID == response.Project.ID {
+			matching = append(matching, query)
+		}
+	}
+	return matching, nil
+}
+
+// findQuery looks up a saved query by ID or name (case-insensitive) among
+// the queries usable in project
+func findQuery(c *client.Client, project, name string) (client.Query, error) {
+	queries, err := getProjectQueries(c, project)
+	if err != nil {
+		return client.Query{}, err
+	}
+
+	var matches []client.Query
+	var names []string
+	for _, query := range queries {
+		if strconv.Itoa(query.ID) == name {
+			return query, nil
+		}
+		if strings.EqualFold(query.Name, name) {
+			matches = append(matches, query)
+		}
+		names = append(names, query.Name)
+	}
+
+	switch len(matches) {
+	case 0:
+		return client.Query{}, fmt.Errorf("saved query '%s' not found (available: %s)", name, joinOrNone(names))
+	case 1:
+		return matches[0], nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, query := range matches {
+		ids = append(ids, strconv.Itoa(query.ID))
+	}
+	return client.Query{}, fmt.Errorf("several saved queries are named '%s' (IDs: %s); give the ID or --project", name, strings.Join(ids, ", "))
+}
+
+func init() {
+	rootCmd.AddCommand(queriesCmd)
+	queriesCmd.AddCommand(listQueriesCmd)
+
+	listQueriesCmd.Flags().String("project", "", "Only list the queries usable in this project (ID or identifier)")
+	listQueriesCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+}
@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var relationsCmd = &cobra.Command{
+	Use:   "relations",
+	Short: "Manage issue relations",
+	Long:  `List, add and remove relations between issues (blocks, precedes, relates, ...)`,
+}
+
+var listRelationsCmd = &cobra.Command{
+	Use:   "list [issue-id]",
+	Short: "List the relations of an issue",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		relationsResp, err := c.GetRelations(issueID)
+		if err != nil {
+			fmt.Printf("Error getting relations: %v\n", err)
+			return
+		}
+		relations := relationsResp.Relations
+
+		if len(relations) == 0 && format == "table" {
+			fmt.Printf("Issue #%d has no relations.\n", issueID)
+			return
+		}
+
+		var otherIDs []int
+		for _, relation := range relations {
+			otherIDs = append(otherIDs, relatedIssueID(issueID, relation))
+		}
+		// Subjects are a convenience; relations are still listed without them
+		others, _ := getIssuesByID(c, otherIDs)
+
+		header := []string{"ID", "Relation", "Status", "Subject"}
+		rows := make([][]string, 0, len(relations))
+		for _, relation := range relations {
+			other := others[relatedIssueID(issueID, relation)]
+			rows = append(rows, []string{
+				strconv.Itoa(relation.ID),
+				formatRelation(issueID, relation),
+				other.Status.Name,
+				other.Subject,
+			})
+		}
+
+		if format == "table" {
+			fmt.Printf("Relations of #%d (Total: %d)\n", issueID, len(relations))
+		}
+		if err := writeOutput(format, header, rows, relations); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var addRelationsCmd = &cobra.Command{
+	Use:   "add [issue-id] [type] [issue-id]",
+	Short: "Relate two issues",
+	Long: `Relate two issues, for example 'redmine relations add 12 blocks 34'.
+
+Types: relates, duplicates, duplicated, blocks, blocked, precedes, follows,
+copied_to and copied_from. --delay sets the number of days between the
+issues of a precedes or follows relation.`,
+	Args: cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+		issueToID, err := parseIssueRef(args[2])
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[2])
+			return
+		}
+
+		relationType := strings.ToLower(args[1])
+		if !containsString(client.RelationTypes, relationType) {
+			fmt.Printf("Invalid relation type: %s (available: %s)\n", args[1], strings.Join(client.RelationTypes, ", "))
+			return
+		}
+
+		data := client.CreateRelationData{IssueToID: issueToID, RelationType: relationType}
+		if cmd.Flags().Changed("delay") {
+			if relationType != "precedes" && relationType != "follows" {
+				fmt.Println("--delay can only be used with precedes and follows relations")
+				return
+			}
+			delay, _ := cmd.Flags().GetInt("delay")
+			data.Delay = &delay
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.CreateRelation(issueID, client.CreateRelationRequest{Relation: data})
+		if err != nil {
+			fmt.Printf("Error adding relation: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Relation added successfully: %d | #%d %s\n", response.Relation.ID, issueID, formatRelation(issueID, response.Relation))
+	},
+}
+
+var removeRelationsCmd = &cobra.Command{
+	Use:   "remove [relation-id | issue-id issue-id]",
+	Short: "Remove issue relations",
+	Long: `Remove a relation by its ID (see 'redmine relations list'), or every relation
+between two issues.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		ids := make([]int, len(args))
+		for i, arg := range args {
+			id, err := parseIssueRef(arg)
+			if err != nil {
+				fmt.Printf("Invalid ID: %s\n", arg)
+				return
+			}
+			ids[i] = id
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		if len(ids) == 1 {
+			if err := c.DeleteRelation(ids[0]); err != nil {
+				fmt.Printf("Error removing relation: %v\n", err)
+				return
+			}
+			fmt.Printf("Relation %d removed\n", ids[0])
+			return
+		}
+
+		relationsResp, err := c.GetRelations(ids[0])
+		if err != nil {
+			fmt.Printf("Error getting relations: %v\n", err)
+			return
+		}
+
+		removed := 0
+		for _, relation := range relationsResp.Relations {
+			if relatedIssueID(ids[0], relation) != ids[1] {
+				continue
+			}
+			if err := c.DeleteRelation(relation.ID); err != nil {
+				fmt.Printf("Error removing relation %d: %v\n", relation.ID, err)
+				return
+			}
+			fmt.Printf("Removed relation %d: #%d %s\n", relation.ID, ids[0], formatRelation(ids[0], relation))
+			removed++
+		}
+		if removed == 0 {
+			fmt.Printf("No relation found between #%d and #%d\n", ids[0], ids[1])
+		}
+	},
+}
+
+// parseIssueRef parses an issue ID written as "123" or "#123"
+func parseIssueRef(value string) (int, error) {
+	return strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(value), "#"))
+}
+
+// relatedIssueID returns the issue on the other side of a relation
+func relatedIssueID(issueID int, relation client.Relation) int {
+	if relation.IssueID == issueID {
+		return relation.IssueToID
+	}
+	return relation.IssueID
+}
+
+// getIssuesByID fetches issues of any status by ID in a single listing
+func getIssuesByID(c *client.Client, ids []int) (map[int]client.Issue, error) {
+	issues := make(map[int]client.Issue)
+	if len(ids) == 0 {
+		return issues, nil
+	}
+
+	sort.Ints(ids)
+	values := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if i == 0 || id != ids[i-1] {
+			values = append(values, strconv.Itoa(id))
+		}
+	}
+
+	list, err := c.GetAllIssues(map[string]string{"issue_id": strings.Join(values, ","), "status_id": "*"})
+	if err != nil {
+		return issues, err
+	}
+	for _, issue := range list {
+		issues[issue.ID] = issue
+	}
+	return issues, nil
+}
+
+func init() {
+	rootCmd.AddCommand(relationsCmd)
+	relationsCmd.AddCommand(listRelationsCmd)
+	relationsCmd.AddCommand(addRelationsCmd)
+	relationsCmd.AddCommand(removeRelationsCmd)
+
+	listRelationsCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	addRelationsCmd.Flags().Int("delay", 0, "Delay in days for precedes/follows relations")
+}
@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+// releaseNotesTemplates are the built-in templates per --format
+var releaseNotesTemplates = map[string]string{
+	"md": `# {{.Project}} {{.Version.Name}} ({{.Date}})
+{{range .Groups}}
+## {{.Name}}
+
+{{range .Issues}}- [#{{.ID}}]({{.URL}}) {{.Subject}}
+{{end}}{{end}}`,
+	"html": `<h1>{{.Project}} {{.Version.Name}} ({{.Date}})</h1>
+{{range .Groups}}
+<h2>{{.Name}}</h2>
+<ul>
+{{range .Issues}}  <li><a href="{{.URL}}">#{{.ID}}</a> {{.Subject}}</li>
+{{end}}</ul>
+{{end}}`,
+	"text": `{{.Project}} {{.Version.Name}} ({{.Date}})
+{{range .Groups}}
+{{.Name}}
+{{range .Issues}}  * #{{.ID}} {{.Subject}}
+    {{.URL}}
+{{end}}{{end}}`,
+}
+
+var releaseNotesCmd = &cobra.Command{
+	Use:   "release-notes [project] [version]",
+	Short: "Generate release notes for a version",
+	Long: `Generate release notes from the closed issues of a version (ID or name).
+
+Issues are grouped by tracker, or by a custom field with --group-by cf:<name or ID>.
+Issues can be left out with --exclude "<custom field>=<value>" or --exclude-tag.
+
+The output is rendered with a Go template. --template replaces the built-in template
+for the format; it receives .Project, .Version, .Date, .Total, .Groups (each with .Name
+and .Issues) and .Issues. Each issue has .ID, .Subject, .Tracker, .Status, .Author,
+.AssignedTo, .ClosedOn, .URL, .Tags and .CustomFields (map by field name).`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		templateText, ok := releaseNotesTemplates[format]
+		if !ok {
+			fmt.Printf("Invalid format: %s (available: md, html, text)\n", format)
+			return
+		}
+		if templatePath, _ := cmd.Flags().GetString("template"); templatePath != "" {
+			data, err := os.ReadFile(templatePath)
+			if err != nil {
+				fmt.Printf("Error reading template: %v\n", err)
+				return
+			}
+			templateText = string(data)
+		}
+
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		groupField, byField := strings.CutPrefix(groupBy, "cf:")
+		if !byField && groupBy != "tracker" {
+			fmt.Printf("Invalid --group-by: %s (use tracker or cf:<custom field>)\n", groupBy)
+			return
+		}
+
+		excludeFlags, _ := cmd.Flags().GetStringArray("exclude")
+		excludes := make([][2]string, 0, len(excludeFlags))
+		for _, exclude := range excludeFlags {
+			name, value, ok := strings.Cut(exclude, "=")
+			if !ok {
+				fmt.Printf("Invalid --exclude: %s (use <custom field>=<value>)\n", exclude)
+				return
+			}
+			excludes = append(excludes, [2]string{strings.TrimSpace(name), strings.TrimSpace(value)})
+		}
+		excludeTags, _ := cmd.Flags().GetStringArray("exclude-tag")
+		output, _ := cmd.Flags().GetString("output")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		version, err := findVersion(c, args[0], args[1])
+		if err != nil {
+			fmt.Printf("Error finding version: %v\n", err)
+			return
+		}
+
+		issues, err := c.GetAllIssues(map[string]string{
+			"fixed_version_id": strconv.Itoa(version.ID),
+			"status_id":        "closed",
+			"sort":             "id",
+		})
+		if err != nil {
+			fmt.Printf("Error getting issues: %v\n", err)
+			return
+		}
+
+		baseURL := strings.TrimSuffix(profile.RedmineURL, "/")
+		notes := releaseNotes{
+			Project: args[0],
+			Version: version,
+			Date:    time.Now().Format("2006-01-02"),
+		}
+		if version.Project != nil {
+			notes.Project = version.Project.Name
+		}
+		if version.DueDate != nil {
+			notes.Date = *version.DueDate
+		}
+
+		excluded := 0
+		fieldGroups := make(map[int]string)
+		for _, issue := range issues {
+			if releaseNoteExcluded(issue, excludes, excludeTags) {
+				excluded++
+				continue
+			}
+			notes.Issues = append(notes.Issues, newReleaseNote(issue, baseURL))
+			for _, field := range releaseNoteFields(issue, groupField) {
+				fieldGroups[issue.ID] = field.Value.String()
+			}
+		}
+		notes.Total = len(notes.Issues)
+		notes.Groups = groupReleaseNotes(notes.Issues, func(note releaseNote) string {
+			if byField {
+				return fieldGroups[note.ID]
+			}
+			return note.Tracker
+		})
+
+		var w io.Writer = os.Stdout
+		if output != "" {
+			file, err := os.Create(output)
+			if err != nil {
+				fmt.Printf("Error creating output file: %v\n", err)
+				return
+			}
+			defer file.Close()
+			w = file
+		}
+
+		if err := renderReleaseNotes(w, format, templateText, notes); err != nil {
+			fmt.Printf("Error rendering release notes: %v\n", err)
+			return
+		}
+
+		if output != "" {
+			fmt.Printf("Wrote release notes for %d issues to %s (%d excluded)\n", notes.Total, output, excluded)
+		}
+	},
+}
+
+// releaseNotes is the data passed to release notes templates
+type releaseNotes struct {
+	Project string
+	Version client.Version
+	Date    string
+	Total   int
+	Groups  []releaseNotesGroup
+	Issues  []releaseNote
+}
+
+type releaseNotesGroup struct {
+	Name   string
+	Issues []releaseNote
+}
+
+type releaseNote struct {
+	ID           int
+	Subject      string
+	Tracker      string
+	Status       string
+	Author       string
+	AssignedTo   string
+	ClosedOn     string
+	URL          string
+	Tags         []string
+	CustomFields map[string]string
+}
+
+func newReleaseNote(issue client.Issue, baseURL string) releaseNote {
+	note := releaseNote{
+		ID:           issue.ID,
+		Subject:      issue.Subject,
+		Tracker:      issue.Tracker.Name,
+		Status:       issue.Status.Name,
+		Author:       issue.Author.Name,
+		AssignedTo:   assigneeName(issue),
+		URL:          fmt.Sprintf("%s/issues/%d", baseURL, issue.ID),
+		CustomFields: make(map[string]string),
+	}
+	if issue.ClosedOn != nil {
+		note.ClosedOn = issue.ClosedOn.Format("2006-01-02")
+	}
+	for _, tag := range issue.Tags {
+		note.Tags = append(note.Tags, tag.Name)
+	}
+	for _, field := range issue.CustomFields {
+		note.CustomFields[field.Name] = field.Value.String()
+	}
+	return note
+}
+
+// releaseNoteExcluded reports whether an issue matches an --exclude custom
+// field value (by field name or ID) or an --exclude-tag
+func releaseNoteExcluded(issue client.Issue, excludes [][2]string, tags []string) bool {
+	for _, exclude := range excludes {
+		for _, field := range releaseNoteFields(issue, exclude[0]) {
+			// Multi-value fields match when any of their values does
+			for _, value := range field.Value.Values {
+				if strings.EqualFold(value, exclude[1]) {
+					return true
+				}
+			}
+		}
+	}
+	for _, tag := range issue.Tags {
+		for _, excluded := range tags {
+			if strings.EqualFold(tag.Name, excluded) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// releaseNoteFields returns the custom fields of an issue matching a field
+// name (case-insensitively) or ID
+func releaseNoteFields(issue client.Issue, ref string) []client.CustomField {
+	var fields []client.CustomField
+	for _, field := range issue.CustomFields {
+		if strings.EqualFold(field.Name, ref) || strconv.Itoa(field.ID) == ref {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// groupReleaseNotes groups notes by key in order of first appearance.
+// Notes without a key are collected in a trailing "Other" group.
+func groupReleaseNotes(notes []releaseNote, key func(releaseNote) string) []releaseNotesGroup {
+	var groups []releaseNotesGroup
+	index := make(map[string]int)
+	var other []releaseNote
+	for _, note := range notes {
+		name := key(note)
+		if name == "" {
+			other = append(other, note)
+			continue
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, releaseNotesGroup{Name: name})
+		}
+		groups[i].Issues = append(groups[i].Issues, note)
+	}
+
+	if len(other) > 0 {
+		groups = append(groups, releaseNotesGroup{Name: "Other", Issues: other})
+	}
+	return groups
+}
+
+// renderReleaseNotes executes the template, escaping values for HTML output
+func renderReleaseNotes(w io.Writer, format, text string, notes releaseNotes) error {
+	funcs := map[string]interface{}{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"join":  strings.Join,
+	}
+
+	if format == "html" {
+		tmpl, err := htmltemplate.New("release-notes").Funcs(funcs).Parse(text)
+		if err != nil {
+			return fmt.Errorf("invalid template: %w", err)
+		}
+		return tmpl.Execute(w, notes)
+	}
+
+	tmpl, err := template.New("release-notes").Funcs(funcs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl.Execute(w, notes)
+}
+
+func init() {
+	rootCmd.AddCommand(releaseNotesCmd)
+
+	releaseNotesCmd.Flags().String("format", "md", "Output format (md, html, text)")
+	releaseNotesCmd.Flags().String("template", "", "Go template file replacing the built-in template")
+	releaseNotesCmd.Flags().String("group-by", "tracker", "Group issues by tracker or cf:<custom field name or ID>")
+	releaseNotesCmd.Flags().StringArray("exclude", nil, "Exclude issues whose custom field has a value (<field>=<value>), can be repeated")
+	releaseNotesCmd.Flags().StringArray("exclude-tag", nil, "Exclude issues with this tag, can be repeated")
+	releaseNotesCmd.Flags().StringP("output", "o", "", "Output file (default: stdout)")
+}
@@ -14,6 +14,7 @@ var rootCmd = &cobra.Command{
 }
 
 var profileFlag string
+var noInputFlag bool
 
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
@@ -24,4 +25,5 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&profileFlag, "profile", "p", "", "Profile to use for this command")
+	rootCmd.PersistentFlags().BoolVar(&noInputFlag, "no-input", false, "Never prompt; fail when a required value is missing (also REDMINE_NO_INPUT=1)")
 }
\ No newline at end of file
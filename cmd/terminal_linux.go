@@ -0,0 +1,5 @@
+package cmd
+
+import "syscall"
+
+const ioctlGetTermios = syscall.TCGETS
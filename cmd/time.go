@@ -0,0 +1,642 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var timeCmd = &cobra.Command{
+	Use:   "time",
+	Short: "Track time spent on issues",
+	Long:  `Log, list, update and summarize Redmine time entries`,
+}
+
+var logTimeCmd = &cobra.Command{
+	Use:   "log [issue_id] [duration]",
+	Short: "Log time on an issue",
+	Long: `Log time spent on an issue.
+
+The duration accepts hours ("1.5", "1.5h"), minutes ("90m"), combined values ("1h30m")
+or clock notation ("1:30").`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		hours, err := parseHours(args[1])
+		if err != nil {
+			fmt.Printf("Invalid duration: %v\n", err)
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		activity, _ := cmd.Flags().GetString("activity")
+		comment, _ := cmd.Flags().GetString("comment")
+		date, _ := cmd.Flags().GetString("date")
+
+		data := client.CreateTimeEntryData{
+			IssueID:  issueID,
+			Hours:    hours,
+			Comments: comment,
+		}
+
+		if date != "" {
+			data.SpentOn, err = parseDateArg(date, time.Now())
+			if err != nil {
+				fmt.Printf("Invalid date: %v\n", err)
+				return
+			}
+		}
+
+		if activity != "" {
+			data.ActivityID, err = resolveActivityID(c, activity)
+			if err != nil {
+				fmt.Printf("Error resolving activity: %v\n", err)
+				return
+			}
+		}
+
+		response, err := c.CreateTimeEntry(client.CreateTimeEntryRequest{TimeEntry: data})
+		if err != nil {
+			fmt.Printf("Error logging time: %v\n", err)
+			return
+		}
+
+		entry := response.TimeEntry
+		fmt.Printf("Time logged successfully: %d | #%d | %s | %s | %s\n",
+			entry.ID,
+			issueID,
+			formatHoursValue(entry.Hours),
+			entry.Activity.Name,
+			entry.SpentOn)
+	},
+}
+
+var listTimeCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List time entries",
+	Long: `List time entries, paging through all matching entries.
+
+--from and --to accept YYYY-MM-DD, "today", "yesterday" or a weekday name such as
+"monday" (the most recent one, today included).`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		params, err := timeEntryFilterParams(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		entries, err := c.GetAllTimeEntries(params)
+		if err != nil {
+			fmt.Printf("Error getting time entries: %v\n", err)
+			return
+		}
+
+		if len(entries) == 0 && format == "table" {
+			fmt.Println("No time entries found.")
+			return
+		}
+
+		header := []string{"ID", "Date", "Hours", "Activity", "Issue", "Project", "User", "Comment"}
+		rows := make([][]string, 0, len(entries))
+		total := 0.0
+		for _, entry := range entries {
+			issue := "-"
+			if entry.Issue != nil {
+				issue = fmt.Sprintf("#%d", entry.Issue.ID)
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(entry.ID),
+				entry.SpentOn,
+				formatHoursValue(entry.Hours),
+				entry.Activity.Name,
+				issue,
+				entry.Project.Name,
+				entry.User.Name,
+				entry.Comments,
+			})
+			total += entry.Hours
+		}
+
+		if format == "table" {
+			fmt.Printf("Time entries (Total: %d, Hours: %s)\n", len(entries), formatHoursValue(total))
+		}
+		if err := writeOutput(format, header, rows, entries); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var reportTimeCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Summarize time entries",
+	Long:  `Summarize hours of matching time entries grouped by issue, project, activity, user or day`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		by, _ := cmd.Flags().GetString("by")
+		keyFunc, ok := timeReportKeys[by]
+		if !ok {
+			fmt.Printf("Invalid --by: %s (available: issue, project, activity, user, day)\n", by)
+			return
+		}
+
+		params, err := timeEntryFilterParams(cmd)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		entries, err := c.GetAllTimeEntries(params)
+		if err != nil {
+			fmt.Printf("Error getting time entries: %v\n", err)
+			return
+		}
+
+		type reportRow struct {
+			Key     string  `json:"key"`
+			Hours   float64 `json:"hours"`
+			Entries int     `json:"entries"`
+		}
+
+		totals := make(map[string]*reportRow)
+		total := 0.0
+		for _, entry := range entries {
+			key := keyFunc(entry)
+			row, ok := totals[key]
+			if !ok {
+				row = &reportRow{Key: key}
+				totals[key] = row
+			}
+			row.Hours += entry.Hours
+			row.Entries++
+			total += entry.Hours
+		}
+
+		report := make([]reportRow, 0, len(totals))
+		for _, row := range totals {
+			report = append(report, *row)
+		}
+		sort.Slice(report, func(i, j int) bool {
+			if by == "day" {
+				return report[i].Key < report[j].Key
+			}
+			if report[i].Hours != report[j].Hours {
+				return report[i].Hours > report[j].Hours
+			}
+			return report[i].Key < report[j].Key
+		})
+
+		header := []string{strings.ToUpper(by[:1]) + by[1:], "Hours", "Entries", "Share"}
+		rows := make([][]string, 0, len(report))
+		for _, row := range report {
+			share := 0.0
+			if total > 0 {
+				share = row.Hours / total * 100
+			}
+			rows = append(rows, []string{
+				row.Key,
+				formatHoursValue(row.Hours),
+				strconv.Itoa(row.Entries),
+				fmt.Sprintf("%.1f%%", share),
+			})
+		}
+
+		if format == "table" {
+			if len(report) == 0 {
+				fmt.Println("No time entries found.")
+				return
+			}
+			fmt.Printf("Time report by %s (Entries: %d, Hours: %s)\n", by, len(entries), formatHoursValue(total))
+		}
+		if err := writeOutput(format, header, rows, report); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var updateTimeCmd = &cobra.Command{
+	Use:   "update [time_entry_id]",
+	Short: "Update a time entry",
+	Long:  `Update the hours, activity, comment, date or issue of an existing time entry`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		entryID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid time entry ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		updateData := client.UpdateTimeEntryData{}
+
+		if cmd.Flags().Changed("hours") {
+			value, _ := cmd.Flags().GetString("hours")
+			hours, err := parseHours(value)
+			if err != nil {
+				fmt.Printf("Invalid duration: %v\n", err)
+				return
+			}
+			updateData.Hours = &hours
+		}
+		if cmd.Flags().Changed("activity") {
+			value, _ := cmd.Flags().GetString("activity")
+			activityID, err := resolveActivityID(c, value)
+			if err != nil {
+				fmt.Printf("Error resolving activity: %v\n", err)
+				return
+			}
+			updateData.ActivityID = &activityID
+		}
+		if cmd.Flags().Changed("comment") {
+			comment, _ := cmd.Flags().GetString("comment")
+			updateData.Comments = &comment
+		}
+		if cmd.Flags().Changed("date") {
+			value, _ := cmd.Flags().GetString("date")
+			date, err := parseDateArg(value, time.Now())
+			if err != nil {
+				fmt.Printf("Invalid date: %v\n", err)
+				return
+			}
+			updateData.SpentOn = &date
+		}
+		if cmd.Flags().Changed("issue") {
+			issueID, _ := cmd.Flags().GetInt("issue")
+			updateData.IssueID = &issueID
+		}
+
+		if updateData.Hours == nil && updateData.ActivityID == nil && updateData.Comments == nil &&
+			updateData.SpentOn == nil && updateData.IssueID == nil {
+			fmt.Println("No update data provided. Please specify at least one option to update.")
+			return
+		}
+
+		if err := c.UpdateTimeEntry(entryID, client.UpdateTimeEntryRequest{TimeEntry: updateData}); err != nil {
+			fmt.Printf("Error updating time entry: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Time entry %d updated successfully\n", entryID)
+	},
+}
+
+var deleteTimeCmd = &cobra.Command{
+	Use:   "delete [time_entry_id...]",
+	Short: "Delete time entries",
+	Long:  `Delete one or more time entries by ID`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		for _, arg := range args {
+			entryID, err := strconv.Atoi(arg)
+			if err != nil {
+				fmt.Printf("Invalid time entry ID: %s\n", arg)
+				continue
+			}
+
+			if err := c.DeleteTimeEntry(entryID); err != nil {
+				fmt.Printf("Error deleting time entry %d: %v\n", entryID, err)
+				continue
+			}
+			fmt.Printf("Time entry %d deleted\n", entryID)
+		}
+	},
+}
+
+// timeReportKeys maps --by values to the grouping key of a time entry
+var timeReportKeys = map[string]func(client.TimeEntry) string{
+	"issue": func(entry client.TimeEntry) string {
+		if entry.Issue == nil {
+			return "(no issue)"
+		}
+		return fmt.Sprintf("#%d", entry.Issue.ID)
+	},
+	"project":  func(entry client.TimeEntry) string { return entry.Project.Name },
+	"activity": func(entry client.TimeEntry) string { return entry.Activity.Name },
+	"user":     func(entry client.TimeEntry) string { return entry.User.Name },
+	"day":      func(entry client.TimeEntry) string { return entry.SpentOn },
+}
+
+// timeEntryFilterParams builds time entry query parameters from the shared
+// filter flags of 'time list' and 'time report'
+func timeEntryFilterParams(cmd *cobra.Command) (map[string]string, error) {
+	params := make(map[string]string)
+	now := time.Now()
+
+	if me, _ := cmd.Flags().GetBool("me"); me {
+		params["user_id"] = "me"
+	}
+	if user, _ := cmd.Flags().GetString("user"); user != "" {
+		params["user_id"] = user
+	}
+	if project, _ := cmd.Flags().GetString("project"); project != "" {
+		params["project_id"] = project
+	}
+	if issue, _ := cmd.Flags().GetString("issue"); issue != "" {
+		params["issue_id"] = strings.TrimPrefix(issue, "#")
+	}
+	if from, _ := cmd.Flags().GetString("from"); from != "" {
+		date, err := parseDateArg(from, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --from: %w", err)
+		}
+		params["from"] = date
+	}
+	if to, _ := cmd.Flags().GetString("to"); to != "" {
+		date, err := parseDateArg(to, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --to: %w", err)
+		}
+		params["to"] = date
+	}
+
+	return params, nil
+}
+
+// resolveActivityID looks up a time entry activity by ID or name
+func resolveActivityID(c *client.Client, value string) (int, error) {
+	activitiesResp, err := c.GetTimeEntryActivities()
+	if err != nil {
+		return 0, err
+	}
+
+	var names []string
+	for _, activity := range activitiesResp.TimeEntryActivities {
+		if strconv.Itoa(activity.ID) == value || strings.EqualFold(activity.Name, value) {
+			return activity.ID, nil
+		}
+		names = append(names, activity.Name)
+	}
+
+	return 0, fmt.Errorf("activity '%s' not found (available: %s)", value, strings.Join(names, ", "))
+}
+
+var durationPattern = regexp.MustCompile(`^(?:(\d+(?:\.\d+)?)h)?(?:(\d+)m)?$`)
+
+// decimalPattern matches plain decimal hours such as 2, 1.5 or .25, and
+// not the exponents, "nan" or "inf" that strconv.ParseFloat also accepts
+var decimalPattern = regexp.MustCompile(`^(?:\d+(?:\.\d*)?|\.\d+)$`)
+
+// clockPattern matches clock notation such as 1:30, without signs
+var clockPattern = regexp.MustCompile(`^(\d+):(\d{1,2})$`)
+
+// parseHours converts a duration such as "1.5h", "90m", "1h30m", "1:30" or
+// "1.5" into decimal hours
+func parseHours(value string) (float64, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+
+	var hours float64
+	if strings.Contains(value, ":") {
+		match := clockPattern.FindStringSubmatch(value)
+		if match == nil {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+		hh, err1 := strconv.Atoi(match[1])
+		mm, err2 := strconv.Atoi(match[2])
+		if err1 != nil || err2 != nil || hh < 0 || mm < 0 || mm >= 60 {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+		hours = float64(hh) + float64(mm)/60
+	} else if decimalPattern.MatchString(value) {
+		h, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration '%s'", value)
+		}
+		hours = h
+	} else if match := durationPattern.FindStringSubmatch(value); match != nil && (match[1] != "" || match[2] != "") {
+		if match[1] != "" {
+			h, _ := strconv.ParseFloat(match[1], 64)
+			hours += h
+		}
+		if match[2] != "" {
+			m, _ := strconv.Atoi(match[2])
+			hours += float64(m) / 60
+		}
+	} else {
+		return 0, fmt.Errorf("invalid duration '%s' (examples: 1.5h, 90m, 1h30m, 1:30)", value)
+	}
+
+	if math.IsNaN(hours) || math.IsInf(hours, 0) {
+		return 0, fmt.Errorf("invalid duration '%s'", value)
+	}
+	// Rounded first so that durations below 0.01h are not sent as 0
+	hours = math.Round(hours*100) / 100
+	if hours <= 0 {
+		return 0, fmt.Errorf("duration must be positive")
+	}
+	return hours, nil
+}
+
+// parseDateArg converts YYYY-MM-DD, "today", "yesterday" or a weekday name
+// (the most recent such day, today included) into YYYY-MM-DD
+func parseDateArg(value string, now time.Time) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch value {
+	case "today":
+		return today.Format("2006-01-02"), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1).Format("2006-01-02"), nil
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			diff := (int(today.Weekday()) - int(day) + 7) % 7
+			return today.AddDate(0, 0, -diff).Format("2006-01-02"), nil
+		}
+	}
+
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return "", fmt.Errorf("'%s' is not a date (use YYYY-MM-DD, today, yesterday or a weekday)", value)
+	}
+	return value, nil
+}
+
+// formatHoursValue formats decimal hours with up to two decimals
+func formatHoursValue(hours float64) string {
+	return strconv.FormatFloat(math.Round(hours*100)/100, 'f', -1, 64)
+}
+
+func init() {
+	rootCmd.AddCommand(timeCmd)
+	timeCmd.AddCommand(logTimeCmd)
+	timeCmd.AddCommand(listTimeCmd)
+	timeCmd.AddCommand(reportTimeCmd)
+	timeCmd.AddCommand(updateTimeCmd)
+	timeCmd.AddCommand(deleteTimeCmd)
+
+	// Add flags to log command
+	logTimeCmd.Flags().String("activity", "", "Activity name or ID (default: the server's default activity)")
+	logTimeCmd.Flags().StringP("comment", "m", "", "Comment for the time entry")
+	logTimeCmd.Flags().String("date", "", "Date the time was spent (default: today)")
+
+	// Add filter flags to list and report commands
+	for _, c := range []*cobra.Command{listTimeCmd, reportTimeCmd} {
+		c.Flags().Bool("me", false, "Only time entries of the current user")
+		c.Flags().String("user", "", "User ID to filter by")
+		c.Flags().String("project", "", "Project ID or identifier to filter by")
+		c.Flags().String("issue", "", "Issue ID to filter by")
+		c.Flags().String("from", "", "Start date (YYYY-MM-DD, today, yesterday, monday...)")
+		c.Flags().String("to", "", "End date (YYYY-MM-DD, today, yesterday, monday...)")
+		c.Flags().String("format", "table", "Output format (table, csv, json)")
+	}
+	reportTimeCmd.Flags().String("by", "issue", "Group by issue, project, activity, user or day")
+
+	// Add flags to update command
+	updateTimeCmd.Flags().String("hours", "", "New duration (e.g. 1.5h, 90m)")
+	updateTimeCmd.Flags().String("activity", "", "New activity name or ID")
+	updateTimeCmd.Flags().StringP("comment", "m", "", "New comment")
+	updateTimeCmd.Flags().String("date", "", "New date")
+	updateTimeCmd.Flags().Int("issue", 0, "Move the time entry to another issue")
+}
@@ -0,0 +1,498 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var timerCmd = &cobra.Command{
+	Use:   "timer",
+	Short: "Track work with a local timer",
+	Long: `Track work on an issue with a local timer that is turned into a Redmine time entry.
+
+The timer state is stored in ~/.redminecli/timers.yaml so it survives terminal restarts.
+On stop, the elapsed time is rounded to the profile's timer_increment (see 'profile set')
+and logged as a time entry. Timers that could not be submitted are kept and can be
+submitted later with 'timer submit'.`,
+}
+
+var startTimerCmd = &cobra.Command{
+	Use:   "start [issue_id]",
+	Short: "Start a timer for an issue",
+	Long:  `Start a timer for an issue, or resume the paused timer of the same issue`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		issueID, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+		if err != nil {
+			fmt.Printf("Invalid issue ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		now := time.Now()
+		activity, _ := cmd.Flags().GetString("activity")
+		comment, _ := cmd.Flags().GetString("comment")
+
+		if state.Active != nil {
+			if state.Active.IssueID != issueID || state.Active.Profile != profile.Name {
+				fmt.Printf("A timer is already active for #%d. Stop it first with 'redmine timer stop'\n", state.Active.IssueID)
+				return
+			}
+			if state.Active.Running() {
+				fmt.Printf("Timer for #%d is already running (%s)\n", issueID, formatElapsed(state.Active.Elapsed(now)))
+				return
+			}
+			state.Active.Resume(now)
+		} else {
+			state.Active = &config.Timer{
+				IssueID:  issueID,
+				Profile:  profile.Name,
+				Segments: []config.TimerSegment{{Start: now}},
+			}
+		}
+		if activity != "" {
+			state.Active.Activity = activity
+		}
+		if comment != "" {
+			state.Active.Comment = comment
+		}
+
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Timer started for #%d at %s\n", issueID, now.Format("15:04"))
+	},
+}
+
+var pauseTimerCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Pause the active timer",
+	Long:  `Pause the active timer. Resume it with 'timer resume' or 'timer start' on the same issue.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		if state.Active == nil {
+			fmt.Println("No active timer.")
+			return
+		}
+		if !state.Active.Running() {
+			fmt.Printf("Timer for #%d is already paused\n", state.Active.IssueID)
+			return
+		}
+
+		now := time.Now()
+		state.Active.Pause(now)
+
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Timer for #%d paused (%s)\n", state.Active.IssueID, formatElapsed(state.Active.Elapsed(now)))
+	},
+}
+
+var resumeTimerCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume the paused timer",
+	Long:  `Resume the paused timer`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		if state.Active == nil {
+			fmt.Println("No active timer.")
+			return
+		}
+		if state.Active.Running() {
+			fmt.Printf("Timer for #%d is already running\n", state.Active.IssueID)
+			return
+		}
+
+		now := time.Now()
+		state.Active.Resume(now)
+
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Timer for #%d resumed (%s so far)\n", state.Active.IssueID, formatElapsed(state.Active.Elapsed(now)))
+	},
+}
+
+var statusTimerCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the active timer",
+	Long:  `Show the active timer and the number of timers waiting to be submitted`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		now := time.Now()
+		if state.Active == nil {
+			fmt.Println("No active timer.")
+		} else {
+			timer := state.Active
+			status := "running"
+			if !timer.Running() {
+				status = "paused"
+			}
+			fmt.Printf("Issue: #%d\n", timer.IssueID)
+			fmt.Printf("Profile: %s\n", timer.Profile)
+			fmt.Printf("Status: %s\n", status)
+			if len(timer.Segments) > 0 {
+				fmt.Printf("Started: %s\n", timer.Segments[0].Start.Format("2006-01-02 15:04"))
+			}
+			fmt.Printf("Elapsed: %s\n", formatElapsed(timer.Elapsed(now)))
+			if timer.Activity != "" {
+				fmt.Printf("Activity: %s\n", timer.Activity)
+			}
+			if timer.Comment != "" {
+				fmt.Printf("Comment: %s\n", timer.Comment)
+			}
+		}
+
+		if len(state.Pending) > 0 {
+			fmt.Printf("\n%d stopped timer(s) not submitted. See 'redmine timer list'.\n", len(state.Pending))
+		}
+	},
+}
+
+var stopTimerCmd = &cobra.Command{
+	Use:   "stop",
+	Short: "Stop the active timer and log the time",
+	Long: `Stop the active timer, round the elapsed time and log it as a time entry.
+If submitting fails, or --no-submit is given, the timer is kept for 'timer submit'.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		if state.Active == nil {
+			fmt.Println("No active timer.")
+			return
+		}
+
+		now := time.Now()
+		timer := *state.Active
+		timer.Pause(now)
+		timer.StoppedAt = &now
+
+		if activity, _ := cmd.Flags().GetString("activity"); activity != "" {
+			timer.Activity = activity
+		}
+		if comment, _ := cmd.Flags().GetString("comment"); comment != "" {
+			timer.Comment = comment
+		}
+		noSubmit, _ := cmd.Flags().GetBool("no-submit")
+		increment, _ := cmd.Flags().GetInt("round")
+
+		state.Active = nil
+		fmt.Printf("Timer for #%d stopped after %s\n", timer.IssueID, formatElapsed(timer.Elapsed(now)))
+
+		if noSubmit {
+			state.Pending = append(state.Pending, timer)
+		} else if err := submitTimer(cfg, timer, increment); err != nil {
+			fmt.Printf("Error submitting time entry: %v\n", err)
+			fmt.Println("The timer was kept; submit it later with 'redmine timer submit'")
+			state.Pending = append(state.Pending, timer)
+		}
+
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+	},
+}
+
+var cancelTimerCmd = &cobra.Command{
+	Use:   "cancel",
+	Short: "Discard the active timer",
+	Long:  `Discard the active timer without logging any time`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		if state.Active == nil {
+			fmt.Println("No active timer.")
+			return
+		}
+
+		issueID := state.Active.IssueID
+		state.Active = nil
+
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Timer for #%d discarded\n", issueID)
+	},
+}
+
+var listTimerCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List stopped timers not yet submitted",
+	Long:  `List stopped timers that have not been submitted as time entries`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		if len(state.Pending) == 0 {
+			fmt.Println("No unsubmitted timers.")
+			return
+		}
+
+		header := []string{"#", "Issue", "Profile", "Date", "Elapsed", "Hours", "Activity", "Comment"}
+		var rows [][]string
+		for i, timer := range state.Pending {
+			increment := config.DefaultTimerIncrement
+			if profile, ok := cfg.Profiles[timer.Profile]; ok {
+				increment = profile.GetTimerIncrement()
+			}
+			stoppedAt := time.Now()
+			if timer.StoppedAt != nil {
+				stoppedAt = *timer.StoppedAt
+			}
+			elapsed := timer.Elapsed(stoppedAt)
+			date := ""
+			if len(timer.Segments) > 0 {
+				date = timer.Segments[0].Start.Format("2006-01-02")
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(i + 1),
+				fmt.Sprintf("#%d", timer.IssueID),
+				timer.Profile,
+				date,
+				formatElapsed(elapsed),
+				formatHoursValue(config.RoundHours(elapsed, increment)),
+				timer.Activity,
+				timer.Comment,
+			})
+		}
+
+		fmt.Printf("Unsubmitted timers (Total: %d)\n", len(state.Pending))
+		printTable(header, rows)
+	},
+}
+
+var submitTimerCmd = &cobra.Command{
+	Use:   "submit [number...]",
+	Short: "Submit stopped timers as time entries",
+	Long:  `Submit the given stopped timers (numbers from 'timer list'), or all of them with --all`,
+	Run: func(cmd *cobra.Command, args []string) {
+		all, _ := cmd.Flags().GetBool("all")
+		if len(args) == 0 && !all {
+			fmt.Println("Specify timer numbers from 'redmine timer list' or use --all")
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		state, err := config.LoadTimers()
+		if err != nil {
+			fmt.Printf("Error loading timers: %v\n", err)
+			return
+		}
+
+		selected := make(map[int]bool)
+		if all {
+			for i := range state.Pending {
+				selected[i] = true
+			}
+		}
+		for _, arg := range args {
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 || n > len(state.Pending) {
+				fmt.Printf("Invalid timer number: %s (available: 1-%d)\n", arg, len(state.Pending))
+				return
+			}
+			selected[n-1] = true
+		}
+
+		increment, _ := cmd.Flags().GetInt("round")
+		comment, _ := cmd.Flags().GetString("comment")
+		activity, _ := cmd.Flags().GetString("activity")
+
+		var remaining []config.Timer
+		submitted := 0
+		for i, timer := range state.Pending {
+			if !selected[i] {
+				remaining = append(remaining, timer)
+				continue
+			}
+			if comment != "" {
+				timer.Comment = comment
+			}
+			if activity != "" {
+				timer.Activity = activity
+			}
+			if err := submitTimer(cfg, timer, increment); err != nil {
+				fmt.Printf("Error submitting timer for #%d: %v\n", timer.IssueID, err)
+				remaining = append(remaining, timer)
+				continue
+			}
+			submitted++
+		}
+
+		state.Pending = remaining
+		if err := state.Save(); err != nil {
+			fmt.Printf("Error saving timers: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Submitted %d timer(s), %d remaining\n", submitted, len(remaining))
+	},
+}
+
+// submitTimer logs a stopped timer as a time entry using the profile the
+// timer was started with. increment overrides the profile's rounding when > 0.
+func submitTimer(cfg *config.Config, timer config.Timer, increment int) error {
+	profile, ok := cfg.Profiles[timer.Profile]
+	if !ok {
+		return fmt.Errorf("profile '%s' not found", timer.Profile)
+	}
+	if profile.APIKey == "" || profile.RedmineURL == "" {
+		return fmt.Errorf("profile '%s' is missing the Redmine URL or API key", timer.Profile)
+	}
+	if increment <= 0 {
+		increment = profile.GetTimerIncrement()
+	}
+
+	c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+	stoppedAt := time.Now()
+	if timer.StoppedAt != nil {
+		stoppedAt = *timer.StoppedAt
+	}
+	hours := config.RoundHours(timer.Elapsed(stoppedAt), increment)
+	if hours == 0 {
+		return fmt.Errorf("no time recorded")
+	}
+
+	data := client.CreateTimeEntryData{
+		IssueID:  timer.IssueID,
+		Hours:    hours,
+		Comments: timer.Comment,
+		SpentOn:  timer.Segments[0].Start.Format("2006-01-02"),
+	}
+	if timer.Activity != "" {
+		activityID, err := resolveActivityID(c, timer.Activity)
+		if err != nil {
+			return err
+		}
+		data.ActivityID = activityID
+	}
+
+	response, err := c.CreateTimeEntry(client.CreateTimeEntryRequest{TimeEntry: data})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Time logged successfully: %d | #%d | %s | %s | %s\n",
+		response.TimeEntry.ID,
+		timer.IssueID,
+		formatHoursValue(response.TimeEntry.Hours),
+		response.TimeEntry.Activity.Name,
+		response.TimeEntry.SpentOn)
+	return nil
+}
+
+// formatElapsed formats a duration as hours and minutes, e.g. "1h05m"
+func formatElapsed(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
+
+func init() {
+	rootCmd.AddCommand(timerCmd)
+	timerCmd.AddCommand(startTimerCmd)
+	timerCmd.AddCommand(pauseTimerCmd)
+	timerCmd.AddCommand(resumeTimerCmd)
+	timerCmd.AddCommand(statusTimerCmd)
+	timerCmd.AddCommand(stopTimerCmd)
+	timerCmd.AddCommand(cancelTimerCmd)
+	timerCmd.AddCommand(listTimerCmd)
+	timerCmd.AddCommand(submitTimerCmd)
+
+	startTimerCmd.Flags().String("activity", "", "Activity name or ID for the time entry")
+	startTimerCmd.Flags().StringP("comment", "m", "", "Comment for the time entry")
+
+	stopTimerCmd.Flags().String("activity", "", "Activity name or ID for the time entry")
+	stopTimerCmd.Flags().StringP("comment", "m", "", "Comment for the time entry")
+	stopTimerCmd.Flags().Bool("no-submit", false, "Keep the stopped timer for a later 'timer submit'")
+	stopTimerCmd.Flags().Int("round", 0, "Rounding increment in minutes (default: profile timer_increment)")
+
+	submitTimerCmd.Flags().Bool("all", false, "Submit all stopped timers")
+	submitTimerCmd.Flags().String("activity", "", "Override the activity of the submitted timers")
+	submitTimerCmd.Flags().StringP("comment", "m", "", "Override the comment of the submitted timers")
+	submitTimerCmd.Flags().Int("round", 0, "Rounding increment in minutes (default: profile timer_increment)")
+}
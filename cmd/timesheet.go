@@ -0,0 +1,759 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var timesheetCmd = &cobra.Command{
+	Use:   "timesheet",
+	Short: "Show the weekly timesheet",
+	Long: `Show time entries of a week as an issues x days grid with row and column totals.
+
+--week accepts an ISO week (2026-W42), a date inside the week, or a relative value
+("today", "monday"...). Weekdays with less time than the profile's timesheet_min_hours
+(see 'profile set') or --min are flagged.
+
+Time logged on a project without an issue is shown as @<project_id>.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		week, _ := cmd.Flags().GetString("week")
+		monday, err := parseWeekArg(week, time.Now())
+		if err != nil {
+			fmt.Printf("Invalid --week: %v\n", err)
+			return
+		}
+
+		user, _ := cmd.Flags().GetString("user")
+		minHours := profile.TimesheetMinHours
+		if cmd.Flags().Changed("min") {
+			minHours, _ = cmd.Flags().GetFloat64("min")
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		sheet, err := loadTimesheet(c, monday, user)
+		if err != nil {
+			fmt.Printf("Error getting time entries: %v\n", err)
+			return
+		}
+
+		underMinimum := sheet.underMinimum(minHours)
+
+		header := []string{"Issue"}
+		for _, day := range sheet.Days {
+			header = append(header, day.Format("Mon 01-02"))
+		}
+		header = append(header, "Total", "Subject")
+
+		var rows [][]string
+		for _, row := range sheet.Rows {
+			cells := []string{row.Key}
+			for i := range sheet.Days {
+				cells = append(cells, formatTimesheetCell(row.hours(i)))
+			}
+			cells = append(cells, formatHoursValue(row.total()), row.Subject)
+			rows = append(rows, cells)
+		}
+
+		switch format {
+		case "json":
+			if err := writeOutput(format, nil, nil, sheet.export(underMinimum)); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		case "csv":
+			totals := []string{"Total"}
+			for i := range sheet.Days {
+				totals = append(totals, formatHoursValue(sheet.dayTotal(i)))
+			}
+			rows = append(rows, append(totals, formatHoursValue(sheet.total()), ""))
+			if err := writeOutput(format, header, rows, nil); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		}
+
+		fmt.Printf("Timesheet %s (%s - %s)\n", isoWeekLabel(monday), sheet.Days[0].Format("2006-01-02"), sheet.Days[6].Format("2006-01-02"))
+		totals := []string{"Total"}
+		for i := range sheet.Days {
+			cell := formatHoursValue(sheet.dayTotal(i))
+			if underMinimum[i] {
+				cell += "!"
+			}
+			totals = append(totals, cell)
+		}
+		rows = append(rows, append(totals, formatHoursValue(sheet.total()), ""))
+		printTable(header, rows)
+
+		var flagged []string
+		for i, day := range sheet.Days {
+			if underMinimum[i] {
+				flagged = append(flagged, fmt.Sprintf("%s (%sh)", day.Format("Mon 2006-01-02"), formatHoursValue(sheet.dayTotal(i))))
+			}
+		}
+		if len(flagged) > 0 {
+			fmt.Printf("\nUnder %sh: %s\n", formatHoursValue(minHours), strings.Join(flagged, ", "))
+		}
+	},
+}
+
+var editTimesheetCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit the weekly timesheet in $EDITOR",
+	Long: `Open your timesheet of a week as TSV in $EDITOR and apply the changes as time entries.
+
+Changing a cell updates the time entry of that issue and day, clearing it (or 0)
+deletes it, and filling an empty cell creates a new entry. Add a row with an issue
+ID (123 or #123) to log time on a new issue; removing a row deletes all of its time
+for the week. Cells accept the same durations as 'time log' (1.5, 90m, 1h30m, 1:30).
+
+The changes are listed before they are applied, and you are asked to confirm
+when time entries would be deleted unless --yes is given. A timesheet saved
+without any rows is not applied.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if !inputAllowed() {
+			fmt.Fprintln(os.Stderr, "Error: 'timesheet edit' needs an interactive terminal; use 'redmine time log' and 'redmine time update' in scripts")
+			os.Exit(1)
+		}
+
+		week, _ := cmd.Flags().GetString("week")
+		monday, err := parseWeekArg(week, time.Now())
+		if err != nil {
+			fmt.Printf("Invalid --week: %v\n", err)
+			return
+		}
+
+		activity, _ := cmd.Flags().GetString("activity")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		sheet, err := loadTimesheet(c, monday, "me")
+		if err != nil {
+			fmt.Printf("Error getting time entries: %v\n", err)
+			return
+		}
+
+		original := sheet.tsv(monday)
+		edited, err := editText(original, "timesheet")
+		if err != nil {
+			fmt.Printf("Error editing timesheet: %v\n", err)
+			return
+		}
+		if edited == original {
+			fmt.Println("No changes.")
+			return
+		}
+
+		values, err := parseTimesheetTSV(edited)
+		if err != nil {
+			fmt.Printf("Error reading timesheet: %v\n", err)
+			return
+		}
+		if len(values) == 0 && len(sheet.Rows) > 0 {
+			fmt.Println("The edited timesheet has no rows; nothing was changed. Clear cells or remove single rows to delete time.")
+			return
+		}
+
+		changes, errs := sheet.diff(values)
+		if len(errs) > 0 {
+			fmt.Printf("Timesheet not applied, %d error(s):\n", len(errs))
+			for _, e := range errs {
+				fmt.Printf("  - %s\n", e)
+			}
+			return
+		}
+		if len(changes) == 0 {
+			fmt.Println("No changes.")
+			return
+		}
+
+		activityID := 0
+		if activity != "" {
+			activityID, err = resolveActivityID(c, activity)
+			if err != nil {
+				fmt.Printf("Error resolving activity: %v\n", err)
+				return
+			}
+		}
+
+		deletes := 0
+		for _, change := range changes {
+			fmt.Println(change.String())
+			if change.Action == "delete" {
+				deletes++
+			}
+		}
+		if dryRun {
+			fmt.Printf("\n%d change(s) not applied (dry run)\n", len(changes))
+			return
+		}
+
+		// Deleted time entries cannot be restored, so confirm them first
+		if yes, _ := cmd.Flags().GetBool("yes"); deletes > 0 && !yes {
+			fmt.Printf("\nApply %d change(s), including %d deletion(s)? [y/N]: ", len(changes), deletes)
+			answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+			answer = strings.ToLower(strings.TrimSpace(answer))
+			if answer != "y" && answer != "yes" {
+				fmt.Println("Aborted, nothing was changed.")
+				return
+			}
+		}
+
+		failed := 0
+		for _, change := range changes {
+			if err := change.apply(c, activityID); err != nil {
+				fmt.Printf("Error: %s: %v\n", change.String(), err)
+				failed++
+			}
+		}
+
+		fmt.Printf("\nApplied %d change(s), %d failed\n", len(changes)-failed, failed)
+	},
+}
+
+// timesheet holds the time entries of a week grouped by issue (or project
+// for entries without an issue) and day
+type timesheet struct {
+	Days []time.Time
+	Rows []*timesheetRow
+}
+
+type timesheetRow struct {
+	// Key is the issue ID, or "@<project_id>" for time logged on a project
+	Key       string
+	IssueID   int
+	ProjectID int
+	Subject   string
+	Entries   [7][]client.TimeEntry
+}
+
+// timesheetChange is a time entry operation derived from an edited timesheet
+type timesheetChange struct {
+	Action    string
+	Entry     client.TimeEntry
+	IssueID   int
+	ProjectID int
+	Date      string
+	Hours     float64
+}
+
+// timesheetExport is the JSON representation of a timesheet
+type timesheetExport struct {
+	Week         string             `json:"week"`
+	From         string             `json:"from"`
+	To           string             `json:"to"`
+	Rows         []timesheetRowJSON `json:"rows"`
+	DayTotals    map[string]float64 `json:"day_totals"`
+	Total        float64            `json:"total"`
+	UnderMinimum []string           `json:"under_minimum,omitempty"`
+}
+
+type timesheetRowJSON struct {
+	IssueID   int                `json:"issue_id,omitempty"`
+	ProjectID int                `json:"project_id,omitempty"`
+	Subject   string             `json:"subject,omitempty"`
+	Hours     map[string]float64 `json:"hours"`
+	Total     float64            `json:"total"`
+}
+
+var isoWeekPattern = regexp.MustCompile(`^(\d{4})-?[Ww](\d{1,2})$`)
+
+// parseWeekArg returns the Monday of the week given as an ISO week
+// (2026-W42), a date or a relative day accepted by parseDateArg.
+// An empty value means the current week.
+func parseWeekArg(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		value = "today"
+	}
+
+	if match := isoWeekPattern.FindStringSubmatch(strings.TrimSpace(value)); match != nil {
+		year, _ := strconv.Atoi(match[1])
+		week, _ := strconv.Atoi(match[2])
+
+		// January 4th is always in ISO week 1
+		jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, now.Location())
+		monday := jan4.AddDate(0, 0, -((int(jan4.Weekday())+6)%7)+(week-1)*7)
+		if y, w := monday.ISOWeek(); week < 1 || y != year || w != week {
+			return time.Time{}, fmt.Errorf("%s has no week %d", match[1], week)
+		}
+		return monday, nil
+	}
+
+	date, err := parseDateArg(value, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("'%s' is not a week (use 2026-W42 or a date)", value)
+	}
+	day, _ := time.ParseInLocation("2006-01-02", date, now.Location())
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7)), nil
+}
+
+// isoWeekLabel formats the ISO week of t, e.g. "2026-W42"
+func isoWeekLabel(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// loadTimesheet fetches the time entries of the week starting on monday.
+// user is passed as user_id; empty means all users visible to the API key.
+func loadTimesheet(c *client.Client, monday time.Time, user string) (*timesheet, error) {
+	sheet := &timesheet{}
+	for i := 0; i < 7; i++ {
+		sheet.Days = append(sheet.Days, monday.AddDate(0, 0, i))
+	}
+
+	params := map[string]string{
+		"from": sheet.Days[0].Format("2006-01-02"),
+		"to":   sheet.Days[6].Format("2006-01-02"),
+	}
+	if user != "" {
+		params["user_id"] = user
+	}
+
+	entries, err := c.GetAllTimeEntries(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make(map[string]*timesheetRow)
+	for _, entry := range entries {
+		day := -1
+		for i, d := range sheet.Days {
+			if d.Format("2006-01-02") == entry.SpentOn {
+				day = i
+			}
+		}
+		if day < 0 {
+			continue
+		}
+
+		row := timesheetRowFor(rows, entry)
+		row.Entries[day] = append(row.Entries[day], entry)
+	}
+
+	sheet.Rows = sortTimesheetRows(rows)
+	sheet.loadSubjects(c)
+	return sheet, nil
+}
+
+// timesheetRowFor returns the row an entry belongs to, adding it if needed
+func timesheetRowFor(rows map[string]*timesheetRow, entry client.TimeEntry) *timesheetRow {
+	key := fmt.Sprintf("@%d", entry.Project.ID)
+	if entry.Issue != nil {
+		key = strconv.Itoa(entry.Issue.ID)
+	}
+
+	row, ok := rows[key]
+	if !ok {
+		row = &timesheetRow{Key: key, ProjectID: entry.Project.ID}
+		if entry.Issue != nil {
+			row.IssueID = entry.Issue.ID
+		} else {
+			row.Subject = entry.Project.Name
+		}
+		rows[key] = row
+	}
+	return row
+}
+
+// loadSubjects fills in issue subjects. Failures are ignored since the
+// subjects are only informational.
+func (s *timesheet) loadSubjects(c *client.Client) {
+	var ids []string
+	for _, row := range s.Rows {
+		if row.IssueID != 0 {
+			ids = append(ids, strconv.Itoa(row.IssueID))
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	issues, err := c.GetAllIssues(map[string]string{"issue_id": strings.Join(ids, ","), "status_id": "*"})
+	if err != nil {
+		return
+	}
+
+	subjects := make(map[int]string)
+	for _, issue := range issues {
+		subjects[issue.ID] = issue.Subject
+	}
+	for _, row := range s.Rows {
+		if row.IssueID != 0 {
+			row.Subject = subjects[row.IssueID]
+		}
+	}
+}
+
+// sortTimesheetRows orders issue rows by ID followed by project rows
+func sortTimesheetRows(rows map[string]*timesheetRow) []*timesheetRow {
+	sorted := make([]*timesheetRow, 0, len(rows))
+	for _, row := range rows {
+		sorted = append(sorted, row)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if (a.IssueID == 0) != (b.IssueID == 0) {
+			return a.IssueID != 0
+		}
+		if a.IssueID != b.IssueID {
+			return a.IssueID < b.IssueID
+		}
+		return a.ProjectID < b.ProjectID
+	})
+	return sorted
+}
+
+func (r *timesheetRow) hours(day int) float64 {
+	total := 0.0
+	for _, entry := range r.Entries[day] {
+		total += entry.Hours
+	}
+	return total
+}
+
+func (r *timesheetRow) total() float64 {
+	total := 0.0
+	for day := range r.Entries {
+		total += r.hours(day)
+	}
+	return total
+}
+
+func (s *timesheet) dayTotal(day int) float64 {
+	total := 0.0
+	for _, row := range s.Rows {
+		total += row.hours(day)
+	}
+	return total
+}
+
+func (s *timesheet) total() float64 {
+	total := 0.0
+	for _, row := range s.Rows {
+		total += row.total()
+	}
+	return total
+}
+
+// underMinimum reports the weekdays (Monday to Friday) with less time than
+// minHours. Weekends are never flagged.
+func (s *timesheet) underMinimum(minHours float64) map[int]bool {
+	flagged := make(map[int]bool)
+	if minHours <= 0 {
+		return flagged
+	}
+	for i, day := range s.Days {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		if s.dayTotal(i) < minHours {
+			flagged[i] = true
+		}
+	}
+	return flagged
+}
+
+func (s *timesheet) export(underMinimum map[int]bool) timesheetExport {
+	export := timesheetExport{
+		Week:      isoWeekLabel(s.Days[0]),
+		From:      s.Days[0].Format("2006-01-02"),
+		To:        s.Days[6].Format("2006-01-02"),
+		Rows:      []timesheetRowJSON{},
+		DayTotals: make(map[string]float64),
+		Total:     s.total(),
+	}
+	for i, day := range s.Days {
+		date := day.Format("2006-01-02")
+		export.DayTotals[date] = s.dayTotal(i)
+		if underMinimum[i] {
+			export.UnderMinimum = append(export.UnderMinimum, date)
+		}
+	}
+	for _, row := range s.Rows {
+		r := timesheetRowJSON{
+			IssueID:   row.IssueID,
+			ProjectID: row.ProjectID,
+			Subject:   row.Subject,
+			Hours:     make(map[string]float64),
+			Total:     row.total(),
+		}
+		for i, day := range s.Days {
+			if hours := row.hours(i); hours > 0 {
+				r.Hours[day.Format("2006-01-02")] = hours
+			}
+		}
+		export.Rows = append(export.Rows, r)
+	}
+	return export
+}
+
+// tsv renders the timesheet for editing. Lines starting with "# " are comments.
+func (s *timesheet) tsv(monday time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Timesheet %s (%s - %s)\n", isoWeekLabel(monday), s.Days[0].Format("2006-01-02"), s.Days[6].Format("2006-01-02"))
+	b.WriteString("# Edit hours per issue and day; clear a cell to delete its time.\n")
+	b.WriteString("# Add a row with an issue ID to log new time. Columns are separated by tabs.\n")
+	b.WriteString("# @<id> rows are time logged on a project without an issue.\n")
+
+	header := []string{"issue"}
+	for _, day := range s.Days {
+		header = append(header, day.Format("Mon 01-02"))
+	}
+	header = append(header, "subject")
+	b.WriteString(strings.Join(header, "\t") + "\n")
+
+	for _, row := range s.Rows {
+		cells := []string{row.Key}
+		for i := range s.Days {
+			cells = append(cells, formatTimesheetCell(row.hours(i)))
+		}
+		cells = append(cells, row.Subject)
+		b.WriteString(strings.Join(cells, "\t") + "\n")
+	}
+	return b.String()
+}
+
+// parseTimesheetTSV reads the hours per row key and day from an edited
+// timesheet. The subject column is ignored.
+func parseTimesheetTSV(text string) (map[string][7]float64, error) {
+	values := make(map[string][7]float64)
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+		// "#123" is an issue row; comments start with "# "
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed == "#" || strings.HasPrefix(trimmed, "# ") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		key := strings.TrimPrefix(strings.TrimSpace(fields[0]), "#")
+		if key == "issue" {
+			continue
+		}
+		if _, err := parseTimesheetKey(key); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if _, exists := values[key]; exists {
+			return nil, fmt.Errorf("line %d: %s appears more than once", lineNo, key)
+		}
+
+		var hours [7]float64
+		for day := 0; day < 7 && day+1 < len(fields); day++ {
+			cell := strings.TrimSpace(fields[day+1])
+			if cell == "" || cell == "0" || cell == "-" {
+				continue
+			}
+			h, err := parseHours(cell)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			hours[day] = h
+		}
+		values[key] = hours
+	}
+	return values, scanner.Err()
+}
+
+// parseTimesheetKey parses a row key: an issue ID or @<project_id>
+func parseTimesheetKey(key string) (*timesheetRow, error) {
+	if projectID, ok := strings.CutPrefix(key, "@"); ok {
+		id, err := strconv.Atoi(projectID)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid project row '%s' (use @<project_id>)", key)
+		}
+		return &timesheetRow{Key: key, ProjectID: id}, nil
+	}
+
+	id, err := strconv.Atoi(key)
+	if err != nil || id <= 0 {
+		return nil, fmt.Errorf("invalid issue ID '%s'", key)
+	}
+	return &timesheetRow{Key: key, IssueID: id}, nil
+}
+
+// diff compares edited hours with the time entries of the timesheet. A cell
+// backed by several entries can only be cleared or have its last entry
+// adjusted while the others stay as they are.
+func (s *timesheet) diff(values map[string][7]float64) ([]timesheetChange, []string) {
+	rows := make(map[string]*timesheetRow)
+	for _, row := range s.Rows {
+		rows[row.Key] = row
+	}
+	var keys []string
+	for key := range values {
+		if _, exists := rows[key]; !exists {
+			row, _ := parseTimesheetKey(key)
+			rows[key] = row
+		}
+	}
+	for _, row := range sortTimesheetRows(rows) {
+		keys = append(keys, row.Key)
+	}
+
+	var changes []timesheetChange
+	var errs []string
+	for _, key := range keys {
+		row := rows[key]
+		edited := values[key]
+		for day := range s.Days {
+			date := s.Days[day].Format("2006-01-02")
+			entries := row.Entries[day]
+			current := row.hours(day)
+			target := edited[day]
+			if math.Abs(current-target) < 0.005 {
+				continue
+			}
+
+			switch {
+			case len(entries) == 0:
+				changes = append(changes, timesheetChange{Action: "create", IssueID: row.IssueID, ProjectID: row.ProjectID, Date: date, Hours: target})
+			case target == 0:
+				for _, entry := range entries {
+					changes = append(changes, timesheetChange{Action: "delete", Entry: entry, IssueID: row.IssueID, ProjectID: row.ProjectID, Date: date})
+				}
+			default:
+				last := entries[len(entries)-1]
+				hours := math.Round((target-(current-last.Hours))*100) / 100
+				if hours <= 0 {
+					errs = append(errs, fmt.Sprintf("%s on %s has %d entries totalling %sh; change them with 'redmine time update'",
+						key, date, len(entries), formatHoursValue(current)))
+					continue
+				}
+				changes = append(changes, timesheetChange{Action: "update", Entry: last, IssueID: row.IssueID, ProjectID: row.ProjectID, Date: date, Hours: hours})
+			}
+		}
+	}
+	return changes, errs
+}
+
+func (ch timesheetChange) target() string {
+	if ch.IssueID != 0 {
+		return fmt.Sprintf("#%d", ch.IssueID)
+	}
+	return fmt.Sprintf("project %d", ch.ProjectID)
+}
+
+func (ch timesheetChange) String() string {
+	switch ch.Action {
+	case "create":
+		return fmt.Sprintf("Create: %s %s %sh", ch.target(), ch.Date, formatHoursValue(ch.Hours))
+	case "update":
+		return fmt.Sprintf("Update: %d %s %s %sh -> %sh", ch.Entry.ID, ch.target(), ch.Date, formatHoursValue(ch.Entry.Hours), formatHoursValue(ch.Hours))
+	default:
+		return fmt.Sprintf("Delete: %d %s %s %sh", ch.Entry.ID, ch.target(), ch.Date, formatHoursValue(ch.Entry.Hours))
+	}
+}
+
+func (ch timesheetChange) apply(c *client.Client, activityID int) error {
+	switch ch.Action {
+	case "create":
+		data := client.CreateTimeEntryData{
+			IssueID:    ch.IssueID,
+			SpentOn:    ch.Date,
+			Hours:      ch.Hours,
+			ActivityID: activityID,
+		}
+		if ch.IssueID == 0 {
+			data.ProjectID = ch.ProjectID
+		}
+		_, err := c.CreateTimeEntry(client.CreateTimeEntryRequest{TimeEntry: data})
+		return err
+	case "update":
+		hours := ch.Hours
+		return c.UpdateTimeEntry(ch.Entry.ID, client.UpdateTimeEntryRequest{TimeEntry: client.UpdateTimeEntryData{Hours: &hours}})
+	default:
+		return c.DeleteTimeEntry(ch.Entry.ID)
+	}
+}
+
+// formatTimesheetCell formats hours for a grid cell, leaving empty days blank
+func formatTimesheetCell(hours float64) string {
+	if hours == 0 {
+		return ""
+	}
+	return formatHoursValue(hours)
+}
+
+func init() {
+	rootCmd.AddCommand(timesheetCmd)
+	timesheetCmd.AddCommand(editTimesheetCmd)
+
+	timesheetCmd.Flags().String("week", "", "Week to show (2026-W42, a date, or today/monday...; default: this week)")
+	timesheetCmd.Flags().String("user", "me", "User ID whose time to show (empty for all users)")
+	timesheetCmd.Flags().Float64("min", 0, "Flag weekdays with fewer hours (default: profile timesheet_min_hours)")
+	timesheetCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+
+	editTimesheetCmd.Flags().String("week", "", "Week to edit (2026-W42, a date, or today/monday...; default: this week)")
+	editTimesheetCmd.Flags().String("activity", "", "Activity name or ID for new entries (default: the server's default activity)")
+	editTimesheetCmd.Flags().Bool("dry-run", false, "Show the changes without applying them")
+	editTimesheetCmd.Flags().BoolP("yes", "y", false, "Apply deletions without asking for confirmation")
+}
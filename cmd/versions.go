@@ -0,0 +1,618 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var versionStatuses = []string{"open", "locked", "closed"}
+var versionSharings = []string{"none", "descendants", "hierarchy", "tree", "system"}
+
+var versionsCmd = &cobra.Command{
+	Use:   "versions",
+	Short: "Manage project versions",
+	Long:  `List, view, create, update and close project versions (milestones)`,
+}
+
+var listVersionsCmd = &cobra.Command{
+	Use:   "list [project]",
+	Short: "List versions of a project",
+	Long:  `List the versions of a project (ID or identifier), including versions shared from other projects`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		status, _ := cmd.Flags().GetString("status")
+		if status != "" && !containsString(versionStatuses, status) {
+			fmt.Printf("Invalid status: %s (available: %s)\n", status, strings.Join(versionStatuses, ", "))
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetVersions(args[0])
+		if err != nil {
+			fmt.Printf("Error getting versions: %v\n", err)
+			return
+		}
+
+		versions := []client.Version{}
+		for _, version := range response.Versions {
+			if status == "" || version.Status == status {
+				versions = append(versions, version)
+			}
+		}
+
+		if len(versions) == 0 && format == "table" {
+			fmt.Println("No versions found.")
+			return
+		}
+
+		header := []string{"ID", "Status", "Due date", "Sharing", "Project", "Name"}
+		rows := make([][]string, 0, len(versions))
+		for _, version := range versions {
+			project := ""
+			if version.Project != nil {
+				project = version.Project.Name
+			}
+			rows = append(rows, []string{
+				strconv.Itoa(version.ID),
+				version.Status,
+				stringOrEmpty(version.DueDate),
+				version.Sharing,
+				project,
+				version.Name,
+			})
+		}
+
+		if format == "table" {
+			fmt.Printf("Versions of %s (Total: %d)\n", args[0], len(versions))
+		}
+		if err := writeOutput(format, header, rows, versions); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var showVersionCmd = &cobra.Command{
+	Use:   "show [version_id]",
+	Short: "Show version details",
+	Long:  `Show detailed information about a version`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		versionID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid version ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetVersion(versionID)
+		if err != nil {
+			fmt.Printf("Error getting version: %v\n", err)
+			return
+		}
+
+		version := response.Version
+
+		fmt.Printf("Version #%d\n", version.ID)
+		fmt.Println(strings.Repeat("=", 50))
+		fmt.Printf("Name: %s\n", version.Name)
+		if version.Project != nil {
+			fmt.Printf("Project: %s\n", version.Project.Name)
+		}
+		fmt.Printf("Status: %s\n", version.Status)
+		if version.DueDate != nil {
+			fmt.Printf("Due date: %s\n", *version.DueDate)
+		}
+		fmt.Printf("Sharing: %s\n", version.Sharing)
+		if version.WikiPageTitle != "" {
+			fmt.Printf("Wiki page: %s\n", version.WikiPageTitle)
+		}
+		if version.EstimatedHours != nil {
+			fmt.Printf("Estimated hours: %s\n", formatHoursValue(*version.EstimatedHours))
+		}
+		if version.SpentHours != nil {
+			fmt.Printf("Spent hours: %s\n", formatHoursValue(*version.SpentHours))
+		}
+		if version.CreatedOn != nil {
+			fmt.Printf("Created: %s\n", version.CreatedOn.Format("2006-01-02 15:04:05"))
+		}
+		if version.UpdatedOn != nil {
+			fmt.Printf("Updated: %s\n", version.UpdatedOn.Format("2006-01-02 15:04:05"))
+		}
+
+		if version.Description != "" {
+			fmt.Println("\nDescription:")
+			fmt.Println(strings.Repeat("-", 20))
+			fmt.Println(version.Description)
+		}
+	},
+}
+
+var createVersionCmd = &cobra.Command{
+	Use:   "create [project] [name]",
+	Short: "Create a version",
+	Long:  `Create a version in a project (ID or identifier)`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		data := client.CreateVersionData{Name: args[1]}
+		data.Description, _ = cmd.Flags().GetString("description")
+		data.Status, _ = cmd.Flags().GetString("status")
+		data.Sharing, _ = cmd.Flags().GetString("sharing")
+		data.WikiPageTitle, _ = cmd.Flags().GetString("wiki-page")
+		if err := validateVersionFields(data.Status, data.Sharing); err != nil {
+			fmt.Println(err)
+			return
+		}
+		if dueDate, _ := cmd.Flags().GetString("due-date"); dueDate != "" {
+			data.DueDate, err = parseDateArg(dueDate, time.Now())
+			if err != nil {
+				fmt.Printf("Invalid --due-date: %v\n", err)
+				return
+			}
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.CreateVersion(args[0], client.CreateVersionRequest{Version: data})
+		if err != nil {
+			fmt.Printf("Error creating version: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Version created successfully: %d | %s | %s\n", response.Version.ID, response.Version.Name, response.Version.Status)
+	},
+}
+
+var updateVersionCmd = &cobra.Command{
+	Use:   "update [version_id]",
+	Short: "Update a version",
+	Long:  `Update a version. Only the given flags are changed.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		versionID, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Printf("Invalid version ID: %s\n", args[0])
+			return
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		var data client.UpdateVersionData
+		hasChanges := false
+
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			data.Name = &name
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("description") {
+			description, _ := cmd.Flags().GetString("description")
+			data.Description = &description
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("status") {
+			status, _ := cmd.Flags().GetString("status")
+			data.Status = &status
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("sharing") {
+			sharing, _ := cmd.Flags().GetString("sharing")
+			data.Sharing = &sharing
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("wiki-page") {
+			wikiPage, _ := cmd.Flags().GetString("wiki-page")
+			data.WikiPageTitle = &wikiPage
+			hasChanges = true
+		}
+		if cmd.Flags().Changed("due-date") {
+			dueDate, _ := cmd.Flags().GetString("due-date")
+			if dueDate != "" {
+				dueDate, err = parseDateArg(dueDate, time.Now())
+				if err != nil {
+					fmt.Printf("Invalid --due-date: %v\n", err)
+					return
+				}
+			}
+			data.DueDate = &dueDate
+			hasChanges = true
+		}
+
+		if !hasChanges {
+			fmt.Println("No changes specified. Use --help to see available options.")
+			return
+		}
+		if err := validateVersionFields(stringOrEmpty(data.Status), stringOrEmpty(data.Sharing)); err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		if err := c.UpdateVersion(versionID, client.UpdateVersionRequest{Version: data}); err != nil {
+			fmt.Printf("Error updating version: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Version %d updated successfully\n", versionID)
+	},
+}
+
+var closeVersionCmd = &cobra.Command{
+	Use:   "close [version_id...]",
+	Short: "Close versions",
+	Long:  `Set the status of one or more versions to closed`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		closed := "closed"
+		for _, arg := range args {
+			versionID, err := strconv.Atoi(arg)
+			if err != nil {
+				fmt.Printf("Invalid version ID: %s\n", arg)
+				continue
+			}
+
+			if err := c.UpdateVersion(versionID, client.UpdateVersionRequest{Version: client.UpdateVersionData{Status: &closed}}); err != nil {
+				fmt.Printf("Error closing version %d: %v\n", versionID, err)
+				continue
+			}
+			fmt.Printf("Version %d closed\n", versionID)
+		}
+	},
+}
+
+var roadmapCmd = &cobra.Command{
+	Use:   "roadmap [project]",
+	Short: "Show the roadmap of a project",
+	Long: `Show each open version of a project with its due date, open and closed issue counts,
+progress and remaining estimated hours.
+
+Progress counts closed issues as done and open issues by their done ratio. Remaining
+hours are the estimated hours of open issues not yet covered by their done ratio.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if format != "table" && format != "json" {
+			fmt.Printf("invalid format: %s (available: table, json)\n", format)
+			return
+		}
+		all, _ := cmd.Flags().GetBool("all")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetVersions(args[0])
+		if err != nil {
+			fmt.Printf("Error getting versions: %v\n", err)
+			return
+		}
+
+		var versions []client.Version
+		for _, version := range response.Versions {
+			if all || version.Status == "open" {
+				versions = append(versions, version)
+			}
+		}
+		sortVersionsByDueDate(versions)
+
+		progress := []versionProgress{}
+		for _, version := range versions {
+			p, err := loadVersionProgress(c, version)
+			if err != nil {
+				fmt.Printf("Error getting issues of %s: %v\n", version.Name, err)
+				return
+			}
+			progress = append(progress, p)
+		}
+
+		if format == "json" {
+			if err := writeOutput(format, nil, nil, progress); err != nil {
+				fmt.Printf("Error writing output: %v\n", err)
+			}
+			return
+		}
+
+		if len(progress) == 0 {
+			fmt.Println("No open versions found.")
+			return
+		}
+
+		now := time.Now()
+		fmt.Printf("Roadmap of %s\n", args[0])
+		for _, p := range progress {
+			fmt.Println()
+			fmt.Printf("%s%s\n", p.Version.Name, formatVersionDue(p.Version, now))
+			fmt.Printf("  %s %3d%%  %d open / %d closed  remaining %sh\n",
+				progressBar(p.Percent, 20), p.Percent, p.Open, p.Closed, formatHoursValue(p.RemainingHours))
+		}
+	},
+}
+
+// versionProgress summarizes the issues assigned to a version
+type versionProgress struct {
+	Version        client.Version `json:"version"`
+	Open           int            `json:"open_issues"`
+	Closed         int            `json:"closed_issues"`
+	Percent        int            `json:"percent_done"`
+	EstimatedHours float64        `json:"estimated_hours"`
+	RemainingHours float64        `json:"remaining_hours"`
+}
+
+func loadVersionProgress(c *client.Client, version client.Version) (versionProgress, error) {
+	p := versionProgress{Version: version}
+	versionID := strconv.Itoa(version.ID)
+
+	open, err := c.GetAllIssues(map[string]string{"fixed_version_id": versionID, "status_id": "open"})
+	if err != nil {
+		return p, err
+	}
+	closed, err := c.GetIssues(map[string]string{"fixed_version_id": versionID, "status_id": "closed", "limit": "1"})
+	if err != nil {
+		return p, err
+	}
+
+	p.Open = len(open)
+	p.Closed = closed.TotalCount
+
+	done := p.Closed * 100
+	for _, issue := range open {
+		done += issue.DoneRatio
+		if issue.EstimatedHours != nil {
+			p.EstimatedHours += *issue.EstimatedHours
+			p.RemainingHours += *issue.EstimatedHours * float64(100-issue.DoneRatio) / 100
+		}
+	}
+	if total := p.Open + p.Closed; total > 0 {
+		p.Percent = done / total
+	}
+	return p, nil
+}
+
+// sortVersionsByDueDate orders versions by due date, versions without one last
+func sortVersionsByDueDate(versions []client.Version) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, b := versions[i].DueDate, versions[j].DueDate
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a < *b
+	})
+}
+
+// formatVersionDue describes the due date of a version relative to now
+func formatVersionDue(version client.Version, now time.Time) string {
+	if version.DueDate == nil {
+		return ""
+	}
+	due, err := time.ParseInLocation("2006-01-02", *version.DueDate, now.Location())
+	if err != nil {
+		return fmt.Sprintf("  (due %s)", *version.DueDate)
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	days := int(due.Sub(today).Hours() / 24)
+	switch {
+	case days < 0:
+		return fmt.Sprintf("  (due %s, %d days late)", *version.DueDate, -days)
+	case days == 0:
+		return fmt.Sprintf("  (due %s, today)", *version.DueDate)
+	default:
+		return fmt.Sprintf("  (due %s, %d days left)", *version.DueDate, days)
+	}
+}
+
+// progressBar renders percent as a bar of the given width, e.g. "[#####-----]"
+func progressBar(percent, width int) string {
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	filled := percent * width / 100
+	return "[" + strings.Repeat("#", filled) + strings.Repeat("-", width-filled) + "]"
+}
+
+func validateVersionFields(status, sharing string) error {
+	if status != "" && !containsString(versionStatuses, status) {
+		return fmt.Errorf("invalid status: %s (available: %s)", status, strings.Join(versionStatuses, ", "))
+	}
+	if sharing != "" && !containsString(versionSharings, sharing) {
+		return fmt.Errorf("invalid sharing: %s (available: %s)", sharing, strings.Join(versionSharings, ", "))
+	}
+	return nil
+}
+
+// findVersion looks up a version of a project by ID or name (case-insensitive)
+func findVersion(c *client.Client, project, value string) (client.Version, error) {
+	response, err := c.GetVersions(project)
+	if err != nil {
+		return client.Version{}, err
+	}
+
+	var names []string
+	for _, version := range response.Versions {
+		if strconv.Itoa(version.ID) == value || strings.EqualFold(version.Name, value) {
+			return version, nil
+		}
+		names = append(names, version.Name)
+	}
+	return client.Version{}, fmt.Errorf("version '%s' not found in %s (available: %s)", value, project, strings.Join(names, ", "))
+}
+
+func init() {
+	rootCmd.AddCommand(versionsCmd)
+	rootCmd.AddCommand(roadmapCmd)
+	versionsCmd.AddCommand(listVersionsCmd)
+	versionsCmd.AddCommand(showVersionCmd)
+	versionsCmd.AddCommand(createVersionCmd)
+	versionsCmd.AddCommand(updateVersionCmd)
+	versionsCmd.AddCommand(closeVersionCmd)
+
+	listVersionsCmd.Flags().String("status", "", "Only versions with this status (open, locked, closed)")
+	listVersionsCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+
+	updateVersionCmd.Flags().String("name", "", "New version name")
+	for _, c := range []*cobra.Command{createVersionCmd, updateVersionCmd} {
+		c.Flags().String("description", "", "Version description")
+		c.Flags().String("status", "", "Version status (open, locked, closed)")
+		c.Flags().String("sharing", "", "Sharing (none, descendants, hierarchy, tree, system)")
+		c.Flags().String("due-date", "", "Due date (YYYY-MM-DD)")
+		c.Flags().String("wiki-page", "", "Wiki page title")
+	}
+
+	roadmapCmd.Flags().Bool("all", false, "Include locked and closed versions")
+	roadmapCmd.Flags().String("format", "table", "Output format (table, json)")
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var watchersCmd = &cobra.Command{
+	Use:   "watchers",
+	Short: "Manage issue watchers",
+	Long:  `Add and remove the watchers of an issue`,
+}
+
+var addWatchersCmd = &cobra.Command{
+	Use:   "add [issue-id] [user...]",
+	Short: "Add watchers to an issue",
+	Long: `Add users to the watchers of an issue. Users are given the same way as
+assignees: "me", a user ID, or an email, login or name.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runWatchers(args, true)
+	},
+}
+
+var removeWatchersCmd = &cobra.Command{
+	Use:   "remove [issue-id] [user...]",
+	Short: "Remove watchers from an issue",
+	Long: `Remove users from the watchers of an issue. Users are given the same way as
+assignees: "me", a user ID, or an email, login or name.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runWatchers(args, false)
+	},
+}
+
+// runWatchers adds or removes the watchers named in args[1:] of issue args[0]
+func runWatchers(args []string, add bool) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Printf("Error loading config: %v\n", err)
+		return
+	}
+
+	profile, err := cfg.GetCurrentProfile()
+	if err != nil {
+		fmt.Printf("Error getting current profile: %v\n", err)
+		fmt.Println("Please add a profile using 'redmine profile add'")
+		return
+	}
+
+	if profile.APIKey == "" {
+		fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	if profile.RedmineURL == "" {
+		fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+		return
+	}
+
+	issueID, err := parseIssueRef(args[0])
+	if err != nil {
+		fmt.Printf("Invalid issue ID: %s\n", args[0])
+		return
+	}
+
+	c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+	response, err := c.GetIssue(issueID, "watchers")
+	if err != nil {
+		fmt.Printf("Error getting issue: %v\n", err)
+		return
+	}
+	issue := response.Issue
+
+	// Current watchers are matched first so that users who are no longer
+	// members can still be removed
+	var known []client.User
+	if !add {
+		known = issue.Watchers
+	}
+	userIDs, err := resolveWatchers(newUserResolver(c), strconv.Itoa(issue.Project.ID), known, args[1:])
+	if err != nil {
+		fmt.Printf("Error resolving watcher: %v\n", err)
+		return
+	}
+
+	for i, userID := range userIDs {
+		watching := false
+		for _, watcher := range issue.Watchers {
+			if watcher.ID == userID {
+				watching = true
+				break
+			}
+		}
+
+		switch {
+		case add && watching:
+			fmt.Printf("%s is already watching #%d\n", args[i+1], issueID)
+		case add:
+			if err := c.AddWatcher(issueID, userID); err != nil {
+				fmt.Printf("Error adding watcher %s: %v\n", args[i+1], err)
+				return
+			}
+			fmt.Printf("%s is now watching #%d\n", args[i+1], issueID)
+		case !watching:
+			fmt.Printf("%s is not watching #%d\n", args[i+1], issueID)
+		default:
+			if err := c.RemoveWatcher(issueID, userID); err != nil {
+				fmt.Printf("Error removing watcher %s: %v\n", args[i+1], err)
+				return
+			}
+			fmt.Printf("%s is no longer watching #%d\n", args[i+1], issueID)
+		}
+	}
+}
+
+// resolveWatchers looks up watchers of an issue by ID, name or login among
+// known users, then in the project through the same lookup as assignees
+func resolveWatchers(users *userResolver, project string, known []client.User, values []string) ([]int, error) {
+	ids := make([]int, 0, len(values))
+	for _, value := range values {
+		if user, ok := findUser(known, value); ok {
+			ids = append(ids, user.ID)
+			continue
+		}
+		id, err := users.resolve(project, value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func init() {
+	rootCmd.AddCommand(watchersCmd)
+	watchersCmd.AddCommand(addWatchersCmd)
+	watchersCmd.AddCommand(removeWatchersCmd)
+}
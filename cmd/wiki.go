@@ -0,0 +1,567 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+)
+
+var wikiCmd = &cobra.Command{
+	Use:   "wiki",
+	Short: "Manage project wiki pages",
+	Long:  `List, view, edit, create and delete the wiki pages of a project`,
+}
+
+var listWikiCmd = &cobra.Command{
+	Use:   "list [project]",
+	Short: "List wiki pages",
+	Long:  `List the wiki pages of a project (ID or identifier)`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+		tree, _ := cmd.Flags().GetBool("tree")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		pagesResp, err := c.GetWikiPages(args[0])
+		if err != nil {
+			fmt.Printf("Error getting wiki pages: %v\n", err)
+			return
+		}
+		pages := pagesResp.WikiPages
+
+		if len(pages) == 0 && format == "table" {
+			fmt.Println("No wiki pages found.")
+			return
+		}
+
+		if tree {
+			printTree(wikiPageTree(pages))
+			return
+		}
+
+		header := []string{"Version", "Updated", "Parent", "Title"}
+		rows := make([][]string, 0, len(pages))
+		for _, page := range pages {
+			updated := ""
+			if page.UpdatedOn != nil {
+				updated = page.UpdatedOn.Format("2006-01-02 15:04")
+			}
+			parent := ""
+			if page.Parent != nil {
+				parent = page.Parent.Title
+			}
+			rows = append(rows, []string{strconv.Itoa(page.Version), updated, parent, page.Title})
+		}
+
+		if format == "table" {
+			fmt.Printf("Wiki pages of %s (Total: %d)\n", args[0], len(pages))
+		}
+		if err := writeOutput(format, header, rows, pages); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var showWikiCmd = &cobra.Command{
+	Use:   "show [project] [page]",
+	Short: "Show a wiki page",
+	Long:  `Show a wiki page, or an old version of it with --version`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		version, _ := cmd.Flags().GetInt("version")
+		raw, _ := cmd.Flags().GetBool("raw")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetWikiPage(args[0], args[1], version, "attachments")
+		if err != nil {
+			fmt.Printf("Error getting wiki page: %v\n", err)
+			return
+		}
+		page := response.WikiPage
+
+		if raw {
+			fmt.Print(page.Text)
+			return
+		}
+
+		fmt.Println(page.Title)
+		fmt.Println(strings.Repeat("=", 50))
+		fmt.Printf("Version: %d\n", page.Version)
+		if page.Parent != nil {
+			fmt.Printf("Parent: %s\n", page.Parent.Title)
+		}
+		if page.Author != nil {
+			fmt.Printf("Author: %s\n", page.Author.Name)
+		}
+		if page.UpdatedOn != nil {
+			fmt.Printf("Updated: %s\n", page.UpdatedOn.Format("2006-01-02 15:04:05"))
+		}
+		if page.Comments != "" {
+			fmt.Printf("Comment: %s\n", page.Comments)
+		}
+		if len(page.Attachments) > 0 {
+			names := make([]string, 0, len(page.Attachments))
+			for _, attachment := range page.Attachments {
+				names = append(names, attachment.Filename)
+			}
+			fmt.Printf("Attachments: %s\n", strings.Join(names, ", "))
+		}
+
+		fmt.Println(strings.Repeat("-", 20))
+		fmt.Println(strings.TrimRight(page.Text, "\n"))
+	},
+}
+
+var historyWikiCmd = &cobra.Command{
+	Use:   "history [project] [page]",
+	Short: "Show the versions of a wiki page",
+	Long: `Show the versions of a wiki page, newest first. Redmine has no history
+endpoint, so each version is fetched; --limit caps the number of versions.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		format, _ := cmd.Flags().GetString("format")
+		if err := validateOutputFormat(format); err != nil {
+			fmt.Println(err)
+			return
+		}
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetWikiPage(args[0], args[1], 0)
+		if err != nil {
+			fmt.Printf("Error getting wiki page: %v\n", err)
+			return
+		}
+
+		versions := []client.WikiPage{response.WikiPage}
+		for v := response.WikiPage.Version - 1; v > 0 && (limit <= 0 || len(versions) < limit); v-- {
+			old, err := c.GetWikiPage(args[0], args[1], v)
+			if err != nil {
+				// Old versions may have been deleted from the history
+				if client.IsNotFound(err) {
+					continue
+				}
+				fmt.Printf("Error getting version %d: %v\n", v, err)
+				return
+			}
+			versions = append(versions, old.WikiPage)
+		}
+
+		header := []string{"Version", "Updated", "Author", "Comment"}
+		rows := make([][]string, 0, len(versions))
+		for _, page := range versions {
+			updated, author := "", ""
+			if page.UpdatedOn != nil {
+				updated = page.UpdatedOn.Format("2006-01-02 15:04")
+			}
+			if page.Author != nil {
+				author = page.Author.Name
+			}
+			rows = append(rows, []string{strconv.Itoa(page.Version), updated, author, page.Comments})
+		}
+
+		if format == "table" {
+			fmt.Printf("History of %s (Versions: %d)\n", response.WikiPage.Title, response.WikiPage.Version)
+		}
+		if err := writeOutput(format, header, rows, versions); err != nil {
+			fmt.Printf("Error writing output: %v\n", err)
+		}
+	},
+}
+
+var editWikiCmd = &cobra.Command{
+	Use:   "edit [project] [page]",
+	Short: "Edit a wiki page",
+	Long: `Edit a wiki page in $EDITOR, or replace its text with --file ('-' for stdin).
+
+The version that was edited is sent with the update. If someone else saved the
+page in the meantime the update is refused and your text is kept in a file.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		comments, _ := cmd.Flags().GetString("comments")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		response, err := c.GetWikiPage(args[0], args[1], 0)
+		if err != nil {
+			fmt.Printf("Error getting wiki page: %v\n", err)
+			return
+		}
+		page := response.WikiPage
+
+		text, err := wikiPageText(cmd, page.Text)
+		if err != nil {
+			fmt.Printf("Error reading page text: %v\n", err)
+			return
+		}
+		if text == "" {
+			fmt.Println("Empty page text, aborting")
+			return
+		}
+		if text == page.Text {
+			fmt.Println("No changes")
+			return
+		}
+
+		err = c.SaveWikiPage(args[0], page.Title, client.SaveWikiPageRequest{
+			WikiPage: client.SaveWikiPageData{Text: text, Comments: comments, Version: page.Version},
+		})
+		if client.IsConflict(err) {
+			fmt.Printf("Error: %s was changed on the server after version %d\n", page.Title, page.Version)
+			path, saveErr := saveRejectedText(page.Title, text)
+			if saveErr != nil {
+				fmt.Printf("Error saving your text to a file: %v\n", saveErr)
+				fmt.Println("Your text follows; copy it before merging:")
+				fmt.Println(text)
+				return
+			}
+			fmt.Printf("Your text was saved to %s; merge it and run 'redmine wiki edit %s %s --file %s'\n", path, args[0], args[1], path)
+			return
+		}
+		if err != nil {
+			fmt.Printf("Error saving wiki page: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Wiki page %s updated (version %d)\n", page.Title, page.Version+1)
+	},
+}
+
+var createWikiCmd = &cobra.Command{
+	Use:   "create [project] [page]",
+	Short: "Create a wiki page",
+	Long:  `Create a wiki page with text written in $EDITOR or read from --file ('-' for stdin)`,
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		comments, _ := cmd.Flags().GetString("comments")
+		parent, _ := cmd.Flags().GetString("parent")
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		// PUT also updates existing pages, so make sure this one is new
+		if _, err := c.GetWikiPage(args[0], args[1], 0); err == nil {
+			fmt.Printf("Wiki page %s already exists; use 'redmine wiki edit %s %s'\n", args[1], args[0], args[1])
+			return
+		} else if !client.IsNotFound(err) {
+			fmt.Printf("Error getting wiki page: %v\n", err)
+			return
+		}
+
+		text, err := wikiPageText(cmd, "")
+		if err != nil {
+			fmt.Printf("Error reading page text: %v\n", err)
+			return
+		}
+		if text == "" {
+			fmt.Println("Empty page text, aborting")
+			return
+		}
+
+		if err := c.SaveWikiPage(args[0], args[1], client.SaveWikiPageRequest{
+			WikiPage: client.SaveWikiPageData{Text: text, Comments: comments, ParentTitle: parent},
+		}); err != nil {
+			fmt.Printf("Error creating wiki page: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Wiki page %s created\n", args[1])
+	},
+}
+
+var deleteWikiCmd = &cobra.Command{
+	Use:   "delete [project] [page]",
+	Short: "Delete a wiki page",
+	Long: `Delete a wiki page with its whole history. Child pages are kept and become
+top-level pages.
+
+The page and its child pages are shown and you are asked to confirm; --yes
+deletes without asking, which is required when running without input.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		yes, _ := cmd.Flags().GetBool("yes")
+		if !yes && !inputAllowed() {
+			exitMissingInput("confirmation", "--yes")
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		if !yes {
+			confirmed, err := confirmWikiDelete(c, args[0], args[1])
+			if err != nil {
+				fmt.Printf("Error getting wiki page: %v\n", err)
+				return
+			}
+			if !confirmed {
+				fmt.Println("Aborted, nothing was deleted.")
+				return
+			}
+		}
+
+		if err := c.DeleteWikiPage(args[0], args[1]); err != nil {
+			fmt.Printf("Error deleting wiki page: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Wiki page %s deleted\n", args[1])
+	},
+}
+
+// confirmWikiDelete shows what deleting a page affects and asks the user to
+// confirm
+func confirmWikiDelete(c *client.Client, project, title string) (bool, error) {
+	response, err := c.GetWikiPage(project, title, 0)
+	if err != nil {
+		return false, err
+	}
+	page := response.WikiPage
+
+	fmt.Printf("Wiki page %s will be deleted with all %d versions.\n", page.Title, page.Version)
+	if index, err := c.GetWikiPages(project); err == nil {
+		var children []string
+		for _, p := range index.WikiPages {
+			if p.Parent != nil && p.Parent.Title == page.Title {
+				children = append(children, p.Title)
+			}
+		}
+		if len(children) > 0 {
+			fmt.Printf("Its child pages become top-level pages: %s\n", strings.Join(children, ", "))
+		}
+	}
+
+	fmt.Print("Delete? [y/N]: ")
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes", nil
+}
+
+// wikiPageText reads the page text from --file, or lets the user edit
+// initial in $EDITOR
+func wikiPageText(cmd *cobra.Command, initial string) (string, error) {
+	if path, _ := cmd.Flags().GetString("file"); path != "" {
+		return readTextSource(path, os.Stdin)
+	}
+	if !inputAllowed() {
+		exitMissingInput("page text", "--file")
+	}
+	return editText(initial, "wiki")
+}
+
+// saveRejectedText keeps text that could not be saved because of a conflict
+func saveRejectedText(title, text string) (string, error) {
+	file, err := os.CreateTemp("", "redmine-wiki-"+title+"-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(text); err != nil {
+		return "", err
+	}
+	return file.Name(), nil
+}
+
+// wikiPageTree arranges pages under their parents, sorted by title
+func wikiPageTree(pages []client.WikiPage) []*treeNode {
+	nodes := make(map[string]*treeNode, len(pages))
+	for _, page := range pages {
+		nodes[page.Title] = &treeNode{Label: page.Title}
+	}
+
+	sorted := make([]client.WikiPage, len(pages))
+	copy(sorted, pages)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Title < sorted[j].Title })
+
+	var roots []*treeNode
+	for _, page := range sorted {
+		node := nodes[page.Title]
+		if page.Parent != nil {
+			if parent, ok := nodes[page.Parent.Title]; ok {
+				parent.Children = append(parent.Children, node)
+				continue
+			}
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
+
+func init() {
+	rootCmd.AddCommand(wikiCmd)
+	wikiCmd.AddCommand(listWikiCmd)
+	wikiCmd.AddCommand(showWikiCmd)
+	wikiCmd.AddCommand(historyWikiCmd)
+	wikiCmd.AddCommand(editWikiCmd)
+	wikiCmd.AddCommand(createWikiCmd)
+	wikiCmd.AddCommand(deleteWikiCmd)
+
+	listWikiCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	listWikiCmd.Flags().Bool("tree", false, "Show pages under their parent pages")
+
+	showWikiCmd.Flags().Int("version", 0, "Show this version of the page (default: current)")
+	showWikiCmd.Flags().Bool("raw", false, "Print only the page text")
+
+	historyWikiCmd.Flags().String("format", "table", "Output format (table, csv, json)")
+	historyWikiCmd.Flags().Int("limit", 20, "Maximum number of versions to show (0 for all)")
+
+	editWikiCmd.Flags().String("file", "", "Read the new page text from a file ('-' for stdin)")
+	editWikiCmd.Flags().StringP("comments", "m", "", "Comment describing the change")
+
+	createWikiCmd.Flags().String("file", "", "Read the page text from a file ('-' for stdin)")
+	createWikiCmd.Flags().StringP("comments", "m", "", "Comment describing the change")
+	createWikiCmd.Flags().String("parent", "", "Title of the parent page")
+
+	deleteWikiCmd.Flags().BoolP("yes", "y", false, "Delete without asking for confirmation")
+}
@@ -0,0 +1,432 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/UNILORN/redmine-cli/client"
+	"github.com/UNILORN/redmine-cli/config"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// wikiSyncStateFile records the checksum of each page as last pulled or
+// pushed, so push can tell which files were changed locally
+const wikiSyncStateFile = ".wiki-sync.yaml"
+
+// wikiAttachmentsDir holds downloaded attachments, one directory per page
+const wikiAttachmentsDir = "attachments"
+
+// wikiFrontMatter is the YAML header of a pulled wiki page file
+type wikiFrontMatter struct {
+	Title       string   `yaml:"title"`
+	Parent      string   `yaml:"parent,omitempty"`
+	Version     int      `yaml:"version,omitempty"`
+	UpdatedOn   string   `yaml:"updated_on,omitempty"`
+	Attachments []string `yaml:"attachments,omitempty"`
+}
+
+// wikiSyncState is stored in wikiSyncStateFile
+type wikiSyncState struct {
+	Project string            `yaml:"project"`
+	Pages   map[string]string `yaml:"pages"`
+}
+
+// wikiFile is a page file read from the sync directory
+type wikiFile struct {
+	Path  string
+	Front wikiFrontMatter
+	Text  string
+}
+
+var pullWikiCmd = &cobra.Command{
+	Use:   "pull [project] [dir]",
+	Short: "Download wiki pages to a local directory",
+	Long: `Download every wiki page of a project to <dir>/<title>.md.
+
+Each file starts with YAML front matter holding the page title, parent page,
+version and attachment names; attachments are saved under
+<dir>/attachments/<title>/, with the attachment ID added to names used by
+more than one attachment of the page. Pages edited locally since the last
+pull or push are left alone unless --force is given.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		project, dir := args[0], args[1]
+		force, _ := cmd.Flags().GetBool("force")
+
+		state, err := loadWikiSyncState(dir)
+		if err != nil {
+			fmt.Printf("Error reading sync state: %v\n", err)
+			return
+		}
+		if state.Project != "" && state.Project != project {
+			fmt.Printf("%s is synced with project %s, not %s\n", dir, state.Project, project)
+			return
+		}
+		state.Project = project
+
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			fmt.Printf("Error creating directory: %v\n", err)
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		pagesResp, err := c.GetWikiPages(project)
+		if err != nil {
+			fmt.Printf("Error getting wiki pages: %v\n", err)
+			return
+		}
+
+		pulled, skipped, attachments := 0, 0, 0
+		for _, index := range pagesResp.WikiPages {
+			path := wikiPageFilePath(dir, index.Title)
+			if local, err := readWikiFile(path); err == nil && !force &&
+				wikiChecksum(local.Front.Parent, local.Text) != state.Pages[index.Title] {
+				fmt.Printf("Skipping %s: changed locally (push it first or use --force)\n", index.Title)
+				skipped++
+				continue
+			}
+
+			response, err := c.GetWikiPage(project, index.Title, 0, "attachments")
+			if err != nil {
+				fmt.Printf("Error getting wiki page %s: %v\n", index.Title, err)
+				return
+			}
+			page := response.WikiPage
+
+			front := wikiPageFrontMatter(page)
+			taken := make(map[string]bool)
+			for _, attachment := range page.Attachments {
+				attachmentPath := filepath.Join(dir, wikiAttachmentsDir, page.Title, attachmentFileName(attachment, taken))
+				front.Attachments = append(front.Attachments, attachment.Filename)
+				if info, err := os.Stat(attachmentPath); err == nil && info.Size() == attachment.Filesize {
+					continue
+				}
+				if err := os.MkdirAll(filepath.Dir(attachmentPath), 0755); err != nil {
+					fmt.Printf("Error creating directory: %v\n", err)
+					return
+				}
+				if _, err := downloadAttachmentTo(c, attachment, attachmentPath); err != nil {
+					fmt.Printf("Error downloading %s: %v\n", attachment.Filename, err)
+					return
+				}
+				attachments++
+			}
+
+			if err := writeWikiFile(path, front, page.Text); err != nil {
+				fmt.Printf("Error writing %s: %v\n", path, err)
+				return
+			}
+			state.Pages[page.Title] = wikiChecksum(front.Parent, page.Text)
+			pulled++
+		}
+
+		if err := state.save(dir); err != nil {
+			fmt.Printf("Error saving sync state: %v\n", err)
+			return
+		}
+
+		fmt.Printf("Pulled %d pages and %d attachments to %s", pulled, attachments, dir)
+		if skipped > 0 {
+			fmt.Printf(" (%d skipped)", skipped)
+		}
+		fmt.Println()
+	},
+}
+
+var pushWikiCmd = &cobra.Command{
+	Use:   "push [project] [dir]",
+	Short: "Upload locally changed wiki pages",
+	Long: `Upload the pages in <dir> whose text or parent changed since they were last
+pulled or pushed. Files without a version in their front matter create new
+pages.
+
+Before anything is uploaded, each changed page is checked against the server.
+If the server version moved on since the version in the front matter, nothing
+is pushed and the conflicting pages are listed. Attachments are not uploaded.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Printf("Error loading config: %v\n", err)
+			return
+		}
+
+		profile, err := cfg.GetCurrentProfile()
+		if err != nil {
+			fmt.Printf("Error getting current profile: %v\n", err)
+			fmt.Println("Please add a profile using 'redmine profile add'")
+			return
+		}
+
+		if profile.APIKey == "" {
+			fmt.Printf("API key not configured for profile '%s'. Please run 'redmine auth token add <token>' or 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		if profile.RedmineURL == "" {
+			fmt.Printf("Redmine URL not configured for profile '%s'. Please run 'redmine profile add'\n", profile.Name)
+			return
+		}
+
+		project, dir := args[0], args[1]
+		comments, _ := cmd.Flags().GetString("comments")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		state, err := loadWikiSyncState(dir)
+		if err != nil {
+			fmt.Printf("Error reading sync state: %v\n", err)
+			return
+		}
+		if state.Project != "" && state.Project != project {
+			fmt.Printf("%s is synced with project %s, not %s\n", dir, state.Project, project)
+			return
+		}
+		state.Project = project
+
+		files, err := readWikiDir(dir)
+		if err != nil {
+			fmt.Printf("Error reading %s: %v\n", dir, err)
+			return
+		}
+
+		var changed []wikiFile
+		for _, file := range files {
+			if wikiChecksum(file.Front.Parent, file.Text) != state.Pages[file.Front.Title] {
+				changed = append(changed, file)
+			}
+		}
+		if len(changed) == 0 {
+			fmt.Println("No local changes to push")
+			return
+		}
+
+		c := client.NewClient(profile.RedmineURL, profile.APIKey)
+
+		// Check every page first so a conflict leaves the wiki untouched
+		header := []string{"Page", "Local version", "Server version", "Updated", "Author"}
+		var conflicts [][]string
+		for _, file := range changed {
+			response, err := c.GetWikiPage(project, file.Front.Title, 0)
+			if client.IsNotFound(err) {
+				if file.Front.Version != 0 {
+					conflicts = append(conflicts, []string{file.Front.Title, strconv.Itoa(file.Front.Version), "deleted", "", ""})
+				}
+				continue
+			}
+			if err != nil {
+				fmt.Printf("Error getting wiki page %s: %v\n", file.Front.Title, err)
+				return
+			}
+
+			page := response.WikiPage
+			if page.Version != file.Front.Version {
+				updated, author := "", ""
+				if page.UpdatedOn != nil {
+					updated = page.UpdatedOn.Format("2006-01-02 15:04")
+				}
+				if page.Author != nil {
+					author = page.Author.Name
+				}
+				conflicts = append(conflicts, []string{page.Title, strconv.Itoa(file.Front.Version), strconv.Itoa(page.Version), updated, author})
+			}
+		}
+
+		if len(conflicts) > 0 {
+			fmt.Printf("Nothing was pushed: %d pages were changed on the server since they were pulled\n\n", len(conflicts))
+			printTable(header, conflicts)
+			fmt.Printf("\nMerge the server changes ('redmine wiki show %s <page>') and set the version in the front matter to the server version, then push again.\n", project)
+			return
+		}
+
+		for _, file := range changed {
+			action, done := "update", "Updated"
+			if file.Front.Version == 0 {
+				action, done = "create", "Created"
+			}
+			if dryRun {
+				fmt.Printf("Would %s %s\n", action, file.Front.Title)
+				continue
+			}
+
+			err := c.SaveWikiPage(project, file.Front.Title, client.SaveWikiPageRequest{
+				WikiPage: client.SaveWikiPageData{
+					Text:        file.Text,
+					Comments:    comments,
+					Version:     file.Front.Version,
+					ParentTitle: file.Front.Parent,
+				},
+			})
+			if client.IsConflict(err) {
+				fmt.Printf("Error: %s was changed on the server while pushing; pull or merge it and push again\n", file.Front.Title)
+				break
+			}
+			if err != nil {
+				fmt.Printf("Error saving wiki page %s: %v\n", file.Front.Title, err)
+				break
+			}
+
+			// Record the new version so the next push is checked against it
+			response, err := c.GetWikiPage(project, file.Front.Title, 0)
+			if err != nil {
+				fmt.Printf("Error getting wiki page %s: %v\n", file.Front.Title, err)
+				break
+			}
+			front := wikiPageFrontMatter(response.WikiPage)
+			front.Attachments = file.Front.Attachments
+			if err := writeWikiFile(file.Path, front, file.Text); err != nil {
+				fmt.Printf("Error writing %s: %v\n", file.Path, err)
+				break
+			}
+			state.Pages[front.Title] = wikiChecksum(front.Parent, file.Text)
+			fmt.Printf("%s %s (version %d)\n", done, front.Title, front.Version)
+		}
+
+		if dryRun {
+			return
+		}
+		if err := state.save(dir); err != nil {
+			fmt.Printf("Error saving sync state: %v\n", err)
+		}
+	},
+}
+
+func wikiPageFrontMatter(page client.WikiPage) wikiFrontMatter {
+	front := wikiFrontMatter{Title: page.Title, Version: page.Version}
+	if page.Parent != nil {
+		front.Parent = page.Parent.Title
+	}
+	if page.UpdatedOn != nil {
+		front.UpdatedOn = page.UpdatedOn.Format("2006-01-02T15:04:05Z07:00")
+	}
+	return front
+}
+
+func wikiPageFilePath(dir, title string) string {
+	return filepath.Join(dir, title+".md")
+}
+
+// wikiChecksum identifies the synced content of a page: its parent and text
+func wikiChecksum(parent, text string) string {
+	sum := sha256.Sum256([]byte(parent + "\n" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+func loadWikiSyncState(dir string) (*wikiSyncState, error) {
+	state := &wikiSyncState{}
+	data, err := os.ReadFile(filepath.Join(dir, wikiSyncStateFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := yaml.Unmarshal(data, state); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", wikiSyncStateFile, err)
+		}
+	}
+	if state.Pages == nil {
+		state.Pages = make(map[string]string)
+	}
+	return state, nil
+}
+
+func (s *wikiSyncState) save(dir string) error {
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, wikiSyncStateFile), data, 0644)
+}
+
+// writeWikiFile writes front matter followed by the page text as is
+func writeWikiFile(path string, front wikiFrontMatter, text string) error {
+	data, err := yaml.Marshal(front)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte("---\n"+string(data)+"---\n"+text), 0644)
+}
+
+// readWikiFile reads a page file. Files without front matter are new pages
+// titled after the file name.
+func readWikiFile(path string) (*wikiFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &wikiFile{Path: path, Text: string(data)}
+	if rest, ok := strings.CutPrefix(file.Text, "---\n"); ok {
+		header, text, found := strings.Cut(rest, "\n---\n")
+		if !found {
+			return nil, fmt.Errorf("%s: unterminated front matter", path)
+		}
+		if err := yaml.Unmarshal([]byte(header), &file.Front); err != nil {
+			return nil, fmt.Errorf("%s: invalid front matter: %w", path, err)
+		}
+		file.Text = text
+	}
+	if file.Front.Title == "" {
+		file.Front.Title = strings.TrimSuffix(filepath.Base(path), ".md")
+	}
+	return file, nil
+}
+
+// readWikiDir reads the page files of a sync directory sorted by title
+func readWikiDir(dir string) ([]wikiFile, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.md"))
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]wikiFile, 0, len(paths))
+	for _, path := range paths {
+		file, err := readWikiFile(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *file)
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Front.Title < files[j].Front.Title })
+	return files, nil
+}
+
+func init() {
+	wikiCmd.AddCommand(pullWikiCmd)
+	wikiCmd.AddCommand(pushWikiCmd)
+
+	pullWikiCmd.Flags().Bool("force", false, "Overwrite pages changed locally")
+
+	pushWikiCmd.Flags().StringP("comments", "m", "", "Comment describing the change")
+	pushWikiCmd.Flags().Bool("dry-run", false, "List the pages that would be pushed without uploading")
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// wordDiffContext is the number of unchanged words kept around changes
+	wordDiffContext = 6
+	// wordDiffMaxCells bounds the LCS table; larger texts are shown as a
+	// single replacement of the differing middle part
+	wordDiffMaxCells = 4_000_000
+)
+
+type wordDiffOp struct {
+	kind   byte // '=', '-' or '+'
+	tokens []string
+}
+
+// wordDiff returns a git-style word diff of two texts, with removed words as
+// [-...-] and added words as {+...+}. Long unchanged stretches are shortened
+// to a few words of context around the changes.
+func wordDiff(oldText, newText string) string {
+	ops := diffTokens(splitWords(oldText), splitWords(newText))
+
+	var b strings.Builder
+	for i, op := range ops {
+		switch op.kind {
+		case '-':
+			b.WriteString("[-" + strings.Join(op.tokens, "") + "-]")
+		case '+':
+			b.WriteString("{+" + strings.Join(op.tokens, "") + "+}")
+		default:
+			b.WriteString(elideContext(op.tokens, i > 0, i < len(ops)-1))
+		}
+	}
+	return b.String()
+}
+
+// elideContext shortens unchanged tokens, keeping wordDiffContext words
+// next to a preceding (before) or following (after) change
+func elideContext(tokens []string, before, after bool) string {
+	keep := wordDiffContext * 2 // words and the whitespace between them
+	switch {
+	case before && after:
+		if len(tokens) > keep*2 {
+			return strings.Join(tokens[:keep], "") + " … " + strings.Join(tokens[len(tokens)-keep:], "")
+		}
+	case before:
+		if len(tokens) > keep {
+			return strings.Join(tokens[:keep], "") + " …"
+		}
+	case after:
+		if len(tokens) > keep {
+			return "… " + strings.Join(tokens[len(tokens)-keep:], "")
+		}
+	}
+	return strings.Join(tokens, "")
+}
+
+// splitWords splits text into words and runs of whitespace. Chinese and
+// Japanese characters are split one by one as they are not separated by
+// spaces.
+func splitWords(text string) []string {
+	var tokens []string
+	start := -1
+	startSpace := false
+	for i, r := range text {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+			if start >= 0 {
+				tokens = append(tokens, text[start:i])
+				start = -1
+			}
+			tokens = append(tokens, string(r))
+			continue
+		}
+		space := unicode.IsSpace(r)
+		if start >= 0 && space != startSpace {
+			tokens = append(tokens, text[start:i])
+			start = -1
+		}
+		if start < 0 {
+			start = i
+			startSpace = space
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
+
+// diffTokens computes the edit operations between two token lists using the
+// longest common subsequence of the part between their common prefix and
+// suffix
+func diffTokens(a, b []string) []wordDiffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var ops []wordDiffOp
+	add := func(kind byte, token string) {
+		if n := len(ops); n > 0 && ops[n-1].kind == kind {
+			ops[n-1].tokens = append(ops[n-1].tokens, token)
+			return
+		}
+		ops = append(ops, wordDiffOp{kind: kind, tokens: []string{token}})
+	}
+
+	for _, token := range a[:prefix] {
+		add('=', token)
+	}
+
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(midA)*len(midB) > wordDiffMaxCells {
+		for _, token := range midA {
+			add('-', token)
+		}
+		for _, token := range midB {
+			add('+', token)
+		}
+	} else {
+		// lengths[i][j] is the LCS length of midA[i:] and midB[j:]
+		lengths := make([][]int32, len(midA)+1)
+		for i := range lengths {
+			lengths[i] = make([]int32, len(midB)+1)
+		}
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lengths[i][j] = lengths[i+1][j+1] + 1
+				} else {
+					lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(midA) && j < len(midB) {
+			switch {
+			case midA[i] == midB[j]:
+				add('=', midA[i])
+				i++
+				j++
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				add('-', midA[i])
+				i++
+			default:
+				add('+', midB[j])
+				j++
+			}
+		}
+		for ; i < len(midA); i++ {
+			add('-', midA[i])
+		}
+		for ; j < len(midB); j++ {
+			add('+', midB[j])
+		}
+	}
+
+	for _, token := range a[len(a)-suffix:] {
+		add('=', token)
+	}
+	return ops
+}
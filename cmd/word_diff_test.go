@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	words := make([]string, 20)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", i+1)
+	}
+	long := strings.Join(words, " ")
+
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{"unchanged", "same text", "same text", "same text"},
+		{"insert", "fix the bug", "fix the nasty bug", "fix the {+nasty +}bug"},
+		{"delete", "fix the nasty bug", "fix the bug", "fix the [-nasty -]bug"},
+		{"replace", "status is open", "status is closed", "status is [-open-]{+closed+}"},
+		{"cjk", "バグを修正", "バグを直す", "バグを[-修正-]{+直す+}"},
+		{"elision", long, strings.TrimSuffix(long, "w20") + "x", "… w14 w15 w16 w17 w18 w19 [-w20-]{+x+}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wordDiff(tt.old, tt.new); got != tt.want {
+				t.Errorf("wordDiff(%q, %q) = %q, want %q", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -12,14 +14,26 @@ type Profile struct {
 	Name       string `yaml:"name"`
 	RedmineURL string `yaml:"redmine_url"`
 	APIKey     string `yaml:"api_key"`
+
+	// TimerIncrement is the rounding increment in minutes for 'timer stop'
+	TimerIncrement int `yaml:"timer_increment,omitempty"`
+	// TimesheetMinHours flags timesheet days with fewer hours; 0 disables it
+	TimesheetMinHours float64 `yaml:"timesheet_min_hours,omitempty"`
+	// StatusShortcuts maps 'issues close', 'reopen', 'start' and 'resolve'
+	// to a status name or ID
+	StatusShortcuts map[string]string `yaml:"status_shortcuts,omitempty"`
 }
 
+// ProfileOptions lists the settings that can be changed with 'profile set'
+var ProfileOptions = []string{"timer_increment", "timesheet_min_hours", "close_status", "reopen_status", "start_status", "resolve_status"}
+
 type Config struct {
 	DefaultProfile string             `yaml:"default_profile"`
 	Profiles       map[string]Profile `yaml:"profiles"`
 }
 
-func GetConfigPath() (string, error) {
+// GetConfigDir returns the ~/.redminecli directory, creating it if needed
+func GetConfigDir() (string, error) {
 	home, err := os.UserHomeDir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get home directory: %w", err)
@@ -30,6 +44,15 @@ func GetConfigPath() (string, error) {
 		return "", fmt.Errorf("failed to create config directory: %w", err)
 	}
 
+	return configDir, nil
+}
+
+func GetConfigPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
 	return filepath.Join(configDir, "config"), nil
 }
 
@@ -145,4 +168,50 @@ func (c *Config) RemoveProfile(name string) error {
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// SetProfileOption changes a setting of the named profile
+func (c *Config) SetProfileOption(name, key, value string) error {
+	profile, exists := c.Profiles[name]
+	if !exists {
+		return fmt.Errorf("profile '%s' does not exist", name)
+	}
+
+	switch key {
+	case "timer_increment":
+		minutes, err := strconv.Atoi(value)
+		if err != nil || minutes < 1 {
+			return fmt.Errorf("timer_increment must be a positive number of minutes")
+		}
+		profile.TimerIncrement = minutes
+	case "timesheet_min_hours":
+		hours, err := strconv.ParseFloat(value, 64)
+		if err != nil || hours < 0 {
+			return fmt.Errorf("timesheet_min_hours must be a number of hours (0 disables the check)")
+		}
+		profile.TimesheetMinHours = hours
+	case "close_status", "reopen_status", "start_status", "resolve_status":
+		action := strings.TrimSuffix(key, "_status")
+		if value == "" {
+			delete(profile.StatusShortcuts, action)
+			break
+		}
+		if profile.StatusShortcuts == nil {
+			profile.StatusShortcuts = make(map[string]string)
+		}
+		profile.StatusShortcuts[action] = value
+	default:
+		return fmt.Errorf("unknown option '%s' (available: %v)", key, ProfileOptions)
+	}
+
+	c.Profiles[name] = profile
+	return nil
+}
+
+// GetTimerIncrement returns the timer rounding increment in minutes
+func (p *Profile) GetTimerIncrement() int {
+	if p.TimerIncrement > 0 {
+		return p.TimerIncrement
+	}
+	return DefaultTimerIncrement
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// replyExtensions are the file extensions tried for a canned reply name
+var replyExtensions = []string{".md", ".txt", ""}
+
+// Reply is a canned comment read from ~/.redminecli/replies. The text may
+// contain Go template expressions such as {{.author}}.
+type Reply struct {
+	Name string
+	Path string
+	Text string
+}
+
+// GetRepliesDir returns the ~/.redminecli/replies directory
+func GetRepliesDir() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "replies"), nil
+}
+
+// LoadReply finds and reads the canned reply with the given name
+func LoadReply(name string) (*Reply, error) {
+	dir, err := GetRepliesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ext := range replyExtensions {
+		path := filepath.Join(dir, name+ext)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return readReply(path)
+		}
+	}
+
+	return nil, fmt.Errorf("reply '%s' not found in %s", name, dir)
+}
+
+// ListReplies returns all canned replies sorted by name
+func ListReplies() ([]*Reply, error) {
+	dir, err := GetRepliesDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read replies directory: %w", err)
+	}
+
+	var replies []*Reply
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		reply, err := readReply(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		replies = append(replies, reply)
+	}
+
+	sort.Slice(replies, func(i, j int) bool {
+		return replies[i].Name < replies[j].Name
+	})
+
+	return replies, nil
+}
+
+func readReply(path string) (*Reply, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reply file: %w", err)
+	}
+
+	return &Reply{
+		Name: strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+		Path: path,
+		Text: string(data),
+	}, nil
+}
+
+// Variables returns the variables referenced by the reply in order of
+// first use
+func (r *Reply) Variables() ([]string, error) {
+	tmpl, err := template.New(r.Name).Funcs(templateFuncs).Parse(r.Text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reply %s: %w", r.Name, err)
+	}
+
+	if tmpl.Tree == nil {
+		return nil, nil
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range referencedVariables(tmpl.Tree.Root) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Render returns the reply text with all variables substituted
+func (r *Reply) Render(vars map[string]string) (string, error) {
+	tmpl, err := template.New(r.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(r.Text)
+	if err != nil {
+		return "", fmt.Errorf("invalid reply %s: %w", r.Name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("failed to render reply %s: %w", r.Name, err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,324 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// localTemplatesDir is the repo-local template directory, looked up from the
+// current working directory upwards
+const localTemplatesDir = ".redminecli/templates"
+
+// TemplateVariable describes a variable used by an issue template
+type TemplateVariable struct {
+	Name    string `yaml:"name"`
+	Prompt  string `yaml:"prompt,omitempty"`
+	Default string `yaml:"default,omitempty"`
+}
+
+// IssueTemplate represents a named issue template loaded from a YAML file.
+// Every string field may contain Go template expressions such as {{.component}}.
+type IssueTemplate struct {
+	Name         string             `yaml:"-"`
+	Path         string             `yaml:"-"`
+	Summary      string             `yaml:"summary,omitempty"`
+	Project      string             `yaml:"project,omitempty"`
+	Tracker      string             `yaml:"tracker,omitempty"`
+	Priority     string             `yaml:"priority,omitempty"`
+	Assignee     string             `yaml:"assignee,omitempty"`
+	Subject      string             `yaml:"subject,omitempty"`
+	Description  string             `yaml:"description,omitempty"`
+	Parent       string             `yaml:"parent,omitempty"`
+	StartDate    string             `yaml:"start_date,omitempty"`
+	DueDate      string             `yaml:"due_date,omitempty"`
+	CustomFields map[string]string  `yaml:"custom_fields,omitempty"`
+	Variables    []TemplateVariable `yaml:"variables,omitempty"`
+}
+
+var templateFuncs = template.FuncMap{
+	"today": func() string { return time.Now().Format("2006-01-02") },
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// GetTemplateDirs returns the directories searched for issue templates.
+// The repo-local directory comes first so it can override user templates.
+func GetTemplateDirs() ([]string, error) {
+	var dirs []string
+
+	if wd, err := os.Getwd(); err == nil {
+		for dir := wd; ; dir = filepath.Dir(dir) {
+			candidate := filepath.Join(dir, localTemplatesDir)
+			if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+				dirs = append(dirs, candidate)
+				break
+			}
+			if filepath.Dir(dir) == dir {
+				break
+			}
+		}
+	}
+
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	dirs = append(dirs, filepath.Join(configDir, "templates"))
+
+	return dirs, nil
+}
+
+// LoadIssueTemplate finds and parses the template with the given name
+func LoadIssueTemplate(name string) (*IssueTemplate, error) {
+	dirs, err := GetTemplateDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, dir := range dirs {
+		for _, ext := range []string{".yaml", ".yml"} {
+			path := filepath.Join(dir, name+ext)
+			if _, err := os.Stat(path); err == nil {
+				return readIssueTemplate(path)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("template '%s' not found in %s", name, strings.Join(dirs, ", "))
+}
+
+// ListIssueTemplates returns all available templates sorted by name.
+// Templates in earlier directories shadow ones with the same name later on.
+func ListIssueTemplates() ([]*IssueTemplate, error) {
+	dirs, err := GetTemplateDirs()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var templates []*IssueTemplate
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template directory: %w", err)
+		}
+
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if entry.IsDir() || (ext != ".yaml" && ext != ".yml") {
+				continue
+			}
+			name := strings.TrimSuffix(entry.Name(), ext)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			tmpl, err := readIssueTemplate(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				return nil, err
+			}
+			templates = append(templates, tmpl)
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+func readIssueTemplate(path string) (*IssueTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file: %w", err)
+	}
+
+	var tmpl IssueTemplate
+	if err := yaml.Unmarshal(data, &tmpl); err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+
+	tmpl.Path = path
+	tmpl.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	return &tmpl, nil
+}
+
+// fields returns pointers to every templated string so they can be walked
+// and rendered uniformly
+func (t *IssueTemplate) fields() []*string {
+	fields := []*string{
+		&t.Project, &t.Tracker, &t.Priority, &t.Assignee, &t.Subject,
+		&t.Description, &t.Parent, &t.StartDate, &t.DueDate,
+	}
+
+	keys := make([]string, 0, len(t.CustomFields))
+	for key := range t.CustomFields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := t.CustomFields[key]
+		fields = append(fields, &value)
+	}
+
+	return fields
+}
+
+// ApplyDefaults fills in declared defaults for variables not present in vars
+func (t *IssueTemplate) ApplyDefaults(vars map[string]string) {
+	for _, v := range t.Variables {
+		if _, ok := vars[v.Name]; !ok && v.Default != "" {
+			vars[v.Name] = v.Default
+		}
+	}
+}
+
+// MissingVariables returns the variables referenced or declared by the
+// template that have no value in vars, in declaration order followed by
+// the order in which they are referenced
+func (t *IssueTemplate) MissingVariables(vars map[string]string) ([]TemplateVariable, error) {
+	var missing []TemplateVariable
+	seen := make(map[string]bool)
+
+	add := func(v TemplateVariable) {
+		if seen[v.Name] {
+			return
+		}
+		seen[v.Name] = true
+		if _, ok := vars[v.Name]; !ok {
+			missing = append(missing, v)
+		}
+	}
+
+	for _, v := range t.Variables {
+		add(v)
+	}
+
+	for _, field := range t.fields() {
+		if *field == "" {
+			continue
+		}
+		tree, err := template.New(t.Name).Funcs(templateFuncs).Parse(*field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", t.Name, err)
+		}
+		for _, name := range referencedVariables(tree.Tree.Root) {
+			add(TemplateVariable{Name: name})
+		}
+	}
+
+	return missing, nil
+}
+
+// Render returns a copy of the template with all variables substituted
+func (t *IssueTemplate) Render(vars map[string]string) (*IssueTemplate, error) {
+	rendered := *t
+	rendered.CustomFields = make(map[string]string, len(t.CustomFields))
+	for key, value := range t.CustomFields {
+		rendered.CustomFields[key] = value
+	}
+
+	render := func(s string) (string, error) {
+		if !strings.Contains(s, "{{") {
+			return s, nil
+		}
+		tmpl, err := template.New(t.Name).Funcs(templateFuncs).Option("missingkey=error").Parse(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid template %s: %w", t.Name, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, vars); err != nil {
+			return "", fmt.Errorf("failed to render template %s: %w", t.Name, err)
+		}
+		return buf.String(), nil
+	}
+
+	for _, field := range []*string{
+		&rendered.Project, &rendered.Tracker, &rendered.Priority, &rendered.Assignee, &rendered.Subject,
+		&rendered.Description, &rendered.Parent, &rendered.StartDate, &rendered.DueDate,
+	} {
+		value, err := render(*field)
+		if err != nil {
+			return nil, err
+		}
+		*field = value
+	}
+
+	for key, value := range rendered.CustomFields {
+		value, err := render(value)
+		if err != nil {
+			return nil, err
+		}
+		rendered.CustomFields[key] = value
+	}
+
+	return &rendered, nil
+}
+
+// referencedVariables walks a template parse tree and collects the top-level
+// field names used, e.g. "component" for {{.component}}
+func referencedVariables(node parse.Node) []string {
+	var names []string
+
+	var walk func(n parse.Node)
+	walk = func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, child := range n.Nodes {
+				walk(child)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, cmd := range n.Cmds {
+				walk(cmd)
+			}
+		case *parse.CommandNode:
+			for _, arg := range n.Args {
+				walk(arg)
+			}
+		case *parse.FieldNode:
+			if len(n.Ident) > 0 {
+				names = append(names, n.Ident[0])
+			}
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.WithNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.TemplateNode:
+			walk(n.Pipe)
+		}
+	}
+	walk(node)
+
+	return names
+}
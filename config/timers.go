@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultTimerIncrement is the rounding increment in minutes used when the
+// profile does not configure one
+const DefaultTimerIncrement = 15
+
+// TimerSegment is a continuous period of work; End is nil while running
+type TimerSegment struct {
+	Start time.Time  `yaml:"start"`
+	End   *time.Time `yaml:"end,omitempty"`
+}
+
+// Timer tracks work on an issue until it is submitted as a time entry
+type Timer struct {
+	IssueID   int            `yaml:"issue_id"`
+	Profile   string         `yaml:"profile"`
+	Activity  string         `yaml:"activity,omitempty"`
+	Comment   string         `yaml:"comment,omitempty"`
+	Segments  []TimerSegment `yaml:"segments"`
+	StoppedAt *time.Time     `yaml:"stopped_at,omitempty"`
+}
+
+// TimerState is the persisted state of the active timer and of stopped
+// timers that have not been submitted yet
+type TimerState struct {
+	Active  *Timer  `yaml:"active,omitempty"`
+	Pending []Timer `yaml:"pending,omitempty"`
+}
+
+func getTimersPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "timers.yaml"), nil
+}
+
+// LoadTimers reads the timer state from ~/.redminecli/timers.yaml
+func LoadTimers() (*TimerState, error) {
+	path, err := getTimersPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &TimerState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read timers file: %w", err)
+	}
+
+	var state TimerState
+	if err := yaml.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal timers: %w", err)
+	}
+
+	return &state, nil
+}
+
+// Save writes the timer state to ~/.redminecli/timers.yaml
+func (s *TimerState) Save() error {
+	path, err := getTimersPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to marshal timers: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write timers file: %w", err)
+	}
+
+	return nil
+}
+
+// Running reports whether the timer has an open segment
+func (t *Timer) Running() bool {
+	return len(t.Segments) > 0 && t.Segments[len(t.Segments)-1].End == nil
+}
+
+// Elapsed returns the total time worked, counting an open segment up to now
+func (t *Timer) Elapsed(now time.Time) time.Duration {
+	var total time.Duration
+	for _, segment := range t.Segments {
+		end := now
+		if segment.End != nil {
+			end = *segment.End
+		}
+		total += end.Sub(segment.Start)
+	}
+	return total
+}
+
+// Pause closes the open segment
+func (t *Timer) Pause(now time.Time) {
+	if t.Running() {
+		t.Segments[len(t.Segments)-1].End = &now
+	}
+}
+
+// Resume opens a new segment
+func (t *Timer) Resume(now time.Time) {
+	if !t.Running() {
+		t.Segments = append(t.Segments, TimerSegment{Start: now})
+	}
+}
+
+// RoundHours rounds a duration to the nearest increment (in minutes) and
+// returns it in hours. Any non-zero duration counts as at least one increment.
+func RoundHours(elapsed time.Duration, increment int) float64 {
+	if increment <= 0 {
+		increment = 1
+	}
+
+	minutes := elapsed.Minutes()
+	if minutes <= 0 {
+		return 0
+	}
+
+	steps := math.Round(minutes / float64(increment))
+	if steps < 1 {
+		steps = 1
+	}
+	return steps * float64(increment) / 60
+}